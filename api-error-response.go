@@ -54,6 +54,8 @@ type ErrorResponse struct {
 	// Region where the bucket is located. This header is returned
 	// only in HEAD bucket and ListObjects response.
 	Region string
+
+	statusCode int
 }
 
 // Error - Returns HTTP error string
@@ -61,6 +63,12 @@ func (e ErrorResponse) Error() string {
 	return e.Message
 }
 
+// StatusCode - Returns the HTTP status code of the response the error
+// was decoded from, 0 if the error didn't originate from a response.
+func (e ErrorResponse) StatusCode() int {
+	return e.statusCode
+}
+
 const (
 	reportIssue = "Please report this issue at https://github.com/minio/minio/issues."
 )
@@ -78,8 +86,9 @@ func httpRespToErrorResponse(resp *http.Response) error {
 	body, err := io.ReadAll(io.LimitReader(resp.Body, 100<<10))
 	if err != nil {
 		return ErrorResponse{
-			Code:    resp.Status,
-			Message: fmt.Sprintf("Failed to read server response: %s.", err),
+			Code:       resp.Status,
+			Message:    fmt.Sprintf("Failed to read server response: %s.", err),
+			statusCode: resp.StatusCode,
 		}
 	}
 
@@ -99,11 +108,13 @@ func httpRespToErrorResponse(resp *http.Response) error {
 				bodyString = bodyString[:1021] + "..."
 			}
 			return ErrorResponse{
-				Code:    resp.Status,
-				Message: fmt.Sprintf("Failed to parse server response (%s): %s", err.Error(), bodyString),
+				Code:       resp.Status,
+				Message:    fmt.Sprintf("Failed to parse server response (%s): %s", err.Error(), bodyString),
+				statusCode: resp.StatusCode,
 			}
 		}
 	}
+	errResp.statusCode = resp.StatusCode
 	return errResp
 }
 
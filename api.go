@@ -94,8 +94,11 @@ const (
 
 // Options for New method
 type Options struct {
-	Creds     *credentials.Credentials
-	Secure    bool
+	Creds  *credentials.Credentials
+	Secure bool
+	// Transport is used for all requests of the client, including
+	// streamed responses like Inspect, e.g. to record and replay them
+	// in tests. DefaultTransport is used when nil.
 	Transport http.RoundTripper
 	// Add future fields here
 }
@@ -354,7 +357,19 @@ func (adm AdminClient) ExecuteMethod(ctx context.Context, method string, reqData
 // request upon any error up to maxRetries attempts in a binomially
 // delayed manner using a standard back off algorithm.
 func (adm AdminClient) executeMethod(ctx context.Context, method string, reqData requestData) (res *http.Response, err error) {
-	reqRetry := MaxRetry // Indicates how many times we can retry the request
+	return adm.executeMethodRetry(ctx, method, reqData, MaxRetry, DefaultRetryUnit, isErrorResponseRetryable)
+}
+
+// isErrorResponseRetryable - is the error response with the given http
+// status and admin error code retryable.
+func isErrorResponseRetryable(httpStatusCode int, code string) bool {
+	return isAdminErrCodeRetryable(code) || isHTTPStatusRetryable(httpStatusCode)
+}
+
+// executeMethodRetry - same as executeMethod, making at most reqRetry
+// attempts with a back off of unit and retrying the error responses for
+// which retryable returns true.
+func (adm AdminClient) executeMethodRetry(ctx context.Context, method string, reqData requestData, reqRetry int, unit time.Duration, retryable func(httpStatusCode int, code string) bool) (res *http.Response, err error) {
 	defer func() {
 		if err != nil {
 			// close idle connections before returning, upon error.
@@ -368,7 +383,7 @@ func (adm AdminClient) executeMethod(ctx context.Context, method string, reqData
 	// Indicate to our routine to exit cleanly upon return.
 	defer cancel()
 
-	for range adm.newRetryTimer(retryCtx, reqRetry, DefaultRetryUnit, DefaultRetryCap, MaxJitter) {
+	for range adm.newRetryTimer(retryCtx, reqRetry, unit, DefaultRetryCap, MaxJitter) {
 		// Instantiate a new request.
 		var req *http.Request
 		req, err = adm.newRequest(ctx, method, reqData)
@@ -417,13 +432,8 @@ func (adm AdminClient) executeMethod(ctx context.Context, method string, reqData
 		errBodySeeker.Seek(0, 0) // Seek back to starting point.
 		res.Body = io.NopCloser(errBodySeeker)
 
-		// Verify if error response code is retryable.
-		if isAdminErrCodeRetryable(errResponse.Code) {
-			continue // Retry.
-		}
-
-		// Verify if http status code is retryable.
-		if isHTTPStatusRetryable(res.StatusCode) {
+		// Verify if error response is retryable.
+		if retryable(res.StatusCode, errResponse.Code) {
 			continue // Retry.
 		}
 
@@ -505,7 +515,10 @@ func (adm AdminClient) newRequest(ctx context.Context, method string, reqData re
 
 	adm.setUserAgent(req)
 	for k, v := range reqData.customHeaders {
-		req.Header.Set(k, v[0])
+		req.Header.Del(k)
+		for _, vv := range v {
+			req.Header.Add(k, vv)
+		}
 	}
 	if length := len(reqData.content); length > 0 {
 		req.ContentLength = int64(length)
@@ -359,6 +359,587 @@ func (z *cpuTimesStat) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *hostInfoStat) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "hostname":
+			z.Hostname, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Hostname")
+				return
+			}
+		case "uptime":
+			z.Uptime, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Uptime")
+				return
+			}
+		case "bootTime":
+			z.BootTime, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "BootTime")
+				return
+			}
+		case "procs":
+			z.Procs, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Procs")
+				return
+			}
+		case "os":
+			z.OS, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "OS")
+				return
+			}
+		case "platform":
+			z.Platform, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Platform")
+				return
+			}
+		case "platformFamily":
+			z.PlatformFamily, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "PlatformFamily")
+				return
+			}
+		case "platformVersion":
+			z.PlatformVersion, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "PlatformVersion")
+				return
+			}
+		case "kernelVersion":
+			z.KernelVersion, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "KernelVersion")
+				return
+			}
+		case "kernelArch":
+			z.KernelArch, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "KernelArch")
+				return
+			}
+		case "virtualizationSystem":
+			z.VirtualizationSystem, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "VirtualizationSystem")
+				return
+			}
+		case "virtualizationRole":
+			z.VirtualizationRole, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "VirtualizationRole")
+				return
+			}
+		case "hostId":
+			z.HostID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "HostID")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *hostInfoStat) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 13
+	// write "hostname"
+	err = en.Append(0x8d, 0xa8, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Hostname)
+	if err != nil {
+		err = msgp.WrapError(err, "Hostname")
+		return
+	}
+	// write "uptime"
+	err = en.Append(0xa6, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Uptime)
+	if err != nil {
+		err = msgp.WrapError(err, "Uptime")
+		return
+	}
+	// write "bootTime"
+	err = en.Append(0xa8, 0x62, 0x6f, 0x6f, 0x74, 0x54, 0x69, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.BootTime)
+	if err != nil {
+		err = msgp.WrapError(err, "BootTime")
+		return
+	}
+	// write "procs"
+	err = en.Append(0xa5, 0x70, 0x72, 0x6f, 0x63, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Procs)
+	if err != nil {
+		err = msgp.WrapError(err, "Procs")
+		return
+	}
+	// write "os"
+	err = en.Append(0xa2, 0x6f, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.OS)
+	if err != nil {
+		err = msgp.WrapError(err, "OS")
+		return
+	}
+	// write "platform"
+	err = en.Append(0xa8, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Platform)
+	if err != nil {
+		err = msgp.WrapError(err, "Platform")
+		return
+	}
+	// write "platformFamily"
+	err = en.Append(0xae, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.PlatformFamily)
+	if err != nil {
+		err = msgp.WrapError(err, "PlatformFamily")
+		return
+	}
+	// write "platformVersion"
+	err = en.Append(0xaf, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.PlatformVersion)
+	if err != nil {
+		err = msgp.WrapError(err, "PlatformVersion")
+		return
+	}
+	// write "kernelVersion"
+	err = en.Append(0xad, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.KernelVersion)
+	if err != nil {
+		err = msgp.WrapError(err, "KernelVersion")
+		return
+	}
+	// write "kernelArch"
+	err = en.Append(0xaa, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x41, 0x72, 0x63, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.KernelArch)
+	if err != nil {
+		err = msgp.WrapError(err, "KernelArch")
+		return
+	}
+	// write "virtualizationSystem"
+	err = en.Append(0xb4, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.VirtualizationSystem)
+	if err != nil {
+		err = msgp.WrapError(err, "VirtualizationSystem")
+		return
+	}
+	// write "virtualizationRole"
+	err = en.Append(0xb2, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.VirtualizationRole)
+	if err != nil {
+		err = msgp.WrapError(err, "VirtualizationRole")
+		return
+	}
+	// write "hostId"
+	err = en.Append(0xa6, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.HostID)
+	if err != nil {
+		err = msgp.WrapError(err, "HostID")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *hostInfoStat) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 13
+	// string "hostname"
+	o = append(o, 0x8d, 0xa8, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Hostname)
+	// string "uptime"
+	o = append(o, 0xa6, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65)
+	o = msgp.AppendUint64(o, z.Uptime)
+	// string "bootTime"
+	o = append(o, 0xa8, 0x62, 0x6f, 0x6f, 0x74, 0x54, 0x69, 0x6d, 0x65)
+	o = msgp.AppendUint64(o, z.BootTime)
+	// string "procs"
+	o = append(o, 0xa5, 0x70, 0x72, 0x6f, 0x63, 0x73)
+	o = msgp.AppendUint64(o, z.Procs)
+	// string "os"
+	o = append(o, 0xa2, 0x6f, 0x73)
+	o = msgp.AppendString(o, z.OS)
+	// string "platform"
+	o = append(o, 0xa8, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d)
+	o = msgp.AppendString(o, z.Platform)
+	// string "platformFamily"
+	o = append(o, 0xae, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79)
+	o = msgp.AppendString(o, z.PlatformFamily)
+	// string "platformVersion"
+	o = append(o, 0xaf, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.PlatformVersion)
+	// string "kernelVersion"
+	o = append(o, 0xad, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.KernelVersion)
+	// string "kernelArch"
+	o = append(o, 0xaa, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x41, 0x72, 0x63, 0x68)
+	o = msgp.AppendString(o, z.KernelArch)
+	// string "virtualizationSystem"
+	o = append(o, 0xb4, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d)
+	o = msgp.AppendString(o, z.VirtualizationSystem)
+	// string "virtualizationRole"
+	o = append(o, 0xb2, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65)
+	o = msgp.AppendString(o, z.VirtualizationRole)
+	// string "hostId"
+	o = append(o, 0xa6, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64)
+	o = msgp.AppendString(o, z.HostID)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *hostInfoStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "hostname":
+			z.Hostname, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Hostname")
+				return
+			}
+		case "uptime":
+			z.Uptime, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Uptime")
+				return
+			}
+		case "bootTime":
+			z.BootTime, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BootTime")
+				return
+			}
+		case "procs":
+			z.Procs, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Procs")
+				return
+			}
+		case "os":
+			z.OS, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "OS")
+				return
+			}
+		case "platform":
+			z.Platform, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Platform")
+				return
+			}
+		case "platformFamily":
+			z.PlatformFamily, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PlatformFamily")
+				return
+			}
+		case "platformVersion":
+			z.PlatformVersion, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PlatformVersion")
+				return
+			}
+		case "kernelVersion":
+			z.KernelVersion, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "KernelVersion")
+				return
+			}
+		case "kernelArch":
+			z.KernelArch, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "KernelArch")
+				return
+			}
+		case "virtualizationSystem":
+			z.VirtualizationSystem, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VirtualizationSystem")
+				return
+			}
+		case "virtualizationRole":
+			z.VirtualizationRole, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VirtualizationRole")
+				return
+			}
+		case "hostId":
+			z.HostID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "HostID")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *hostInfoStat) Msgsize() (s int) {
+	s = 1 + 9 + msgp.StringPrefixSize + len(z.Hostname) + 7 + msgp.Uint64Size + 9 + msgp.Uint64Size + 6 + msgp.Uint64Size + 3 + msgp.StringPrefixSize + len(z.OS) + 9 + msgp.StringPrefixSize + len(z.Platform) + 15 + msgp.StringPrefixSize + len(z.PlatformFamily) + 16 + msgp.StringPrefixSize + len(z.PlatformVersion) + 14 + msgp.StringPrefixSize + len(z.KernelVersion) + 11 + msgp.StringPrefixSize + len(z.KernelArch) + 21 + msgp.StringPrefixSize + len(z.VirtualizationSystem) + 19 + msgp.StringPrefixSize + len(z.VirtualizationRole) + 7 + msgp.StringPrefixSize + len(z.HostID)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *hostTemperatureStat) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "sensorKey":
+			z.SensorKey, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "SensorKey")
+				return
+			}
+		case "temperature":
+			z.Temperature, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Temperature")
+				return
+			}
+		case "sensorHigh":
+			z.High, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "High")
+				return
+			}
+		case "sensorCritical":
+			z.Critical, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Critical")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *hostTemperatureStat) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "sensorKey"
+	err = en.Append(0x84, 0xa9, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4b, 0x65, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.SensorKey)
+	if err != nil {
+		err = msgp.WrapError(err, "SensorKey")
+		return
+	}
+	// write "temperature"
+	err = en.Append(0xab, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Temperature)
+	if err != nil {
+		err = msgp.WrapError(err, "Temperature")
+		return
+	}
+	// write "sensorHigh"
+	err = en.Append(0xaa, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x48, 0x69, 0x67, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.High)
+	if err != nil {
+		err = msgp.WrapError(err, "High")
+		return
+	}
+	// write "sensorCritical"
+	err = en.Append(0xae, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Critical)
+	if err != nil {
+		err = msgp.WrapError(err, "Critical")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *hostTemperatureStat) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "sensorKey"
+	o = append(o, 0x84, 0xa9, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4b, 0x65, 0x79)
+	o = msgp.AppendString(o, z.SensorKey)
+	// string "temperature"
+	o = append(o, 0xab, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65)
+	o = msgp.AppendFloat64(o, z.Temperature)
+	// string "sensorHigh"
+	o = append(o, 0xaa, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x48, 0x69, 0x67, 0x68)
+	o = msgp.AppendFloat64(o, z.High)
+	// string "sensorCritical"
+	o = append(o, 0xae, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c)
+	o = msgp.AppendFloat64(o, z.Critical)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *hostTemperatureStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "sensorKey":
+			z.SensorKey, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SensorKey")
+				return
+			}
+		case "temperature":
+			z.Temperature, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Temperature")
+				return
+			}
+		case "sensorHigh":
+			z.High, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "High")
+				return
+			}
+		case "sensorCritical":
+			z.Critical, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Critical")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *hostTemperatureStat) Msgsize() (s int) {
+	s = 1 + 10 + msgp.StringPrefixSize + len(z.SensorKey) + 12 + msgp.Float64Size + 11 + msgp.Float64Size + 15 + msgp.Float64Size
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *loadAvgStat) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -377,22 +958,740 @@ func (z *loadAvgStat) DecodeMsg(dc *msgp.Reader) (err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
-		case "load1":
-			z.Load1, err = dc.ReadFloat64()
+		case "load1":
+			z.Load1, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Load1")
+				return
+			}
+		case "load5":
+			z.Load5, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Load5")
+				return
+			}
+		case "load15":
+			z.Load15, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Load15")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z loadAvgStat) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "load1"
+	err = en.Append(0x83, 0xa5, 0x6c, 0x6f, 0x61, 0x64, 0x31)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Load1)
+	if err != nil {
+		err = msgp.WrapError(err, "Load1")
+		return
+	}
+	// write "load5"
+	err = en.Append(0xa5, 0x6c, 0x6f, 0x61, 0x64, 0x35)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Load5)
+	if err != nil {
+		err = msgp.WrapError(err, "Load5")
+		return
+	}
+	// write "load15"
+	err = en.Append(0xa6, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Load15)
+	if err != nil {
+		err = msgp.WrapError(err, "Load15")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z loadAvgStat) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "load1"
+	o = append(o, 0x83, 0xa5, 0x6c, 0x6f, 0x61, 0x64, 0x31)
+	o = msgp.AppendFloat64(o, z.Load1)
+	// string "load5"
+	o = append(o, 0xa5, 0x6c, 0x6f, 0x61, 0x64, 0x35)
+	o = msgp.AppendFloat64(o, z.Load5)
+	// string "load15"
+	o = append(o, 0xa6, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35)
+	o = msgp.AppendFloat64(o, z.Load15)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *loadAvgStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "load1":
+			z.Load1, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Load1")
+				return
+			}
+		case "load5":
+			z.Load5, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Load5")
+				return
+			}
+		case "load15":
+			z.Load15, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Load15")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z loadAvgStat) Msgsize() (s int) {
+	s = 1 + 6 + msgp.Float64Size + 6 + msgp.Float64Size + 7 + msgp.Float64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *processIOCountersStat) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "readCount":
+			z.ReadCount, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ReadCount")
+				return
+			}
+		case "writeCount":
+			z.WriteCount, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "WriteCount")
+				return
+			}
+		case "readBytes":
+			z.ReadBytes, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ReadBytes")
+				return
+			}
+		case "writeBytes":
+			z.WriteBytes, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "WriteBytes")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *processIOCountersStat) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "readCount"
+	err = en.Append(0x84, 0xa9, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ReadCount)
+	if err != nil {
+		err = msgp.WrapError(err, "ReadCount")
+		return
+	}
+	// write "writeCount"
+	err = en.Append(0xaa, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.WriteCount)
+	if err != nil {
+		err = msgp.WrapError(err, "WriteCount")
+		return
+	}
+	// write "readBytes"
+	err = en.Append(0xa9, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ReadBytes)
+	if err != nil {
+		err = msgp.WrapError(err, "ReadBytes")
+		return
+	}
+	// write "writeBytes"
+	err = en.Append(0xaa, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.WriteBytes)
+	if err != nil {
+		err = msgp.WrapError(err, "WriteBytes")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *processIOCountersStat) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "readCount"
+	o = append(o, 0x84, 0xa9, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+	o = msgp.AppendUint64(o, z.ReadCount)
+	// string "writeCount"
+	o = append(o, 0xaa, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+	o = msgp.AppendUint64(o, z.WriteCount)
+	// string "readBytes"
+	o = append(o, 0xa9, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73)
+	o = msgp.AppendUint64(o, z.ReadBytes)
+	// string "writeBytes"
+	o = append(o, 0xaa, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73)
+	o = msgp.AppendUint64(o, z.WriteBytes)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *processIOCountersStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "readCount":
+			z.ReadCount, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReadCount")
+				return
+			}
+		case "writeCount":
+			z.WriteCount, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "WriteCount")
+				return
+			}
+		case "readBytes":
+			z.ReadBytes, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReadBytes")
+				return
+			}
+		case "writeBytes":
+			z.WriteBytes, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "WriteBytes")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *processIOCountersStat) Msgsize() (s int) {
+	s = 1 + 10 + msgp.Uint64Size + 11 + msgp.Uint64Size + 10 + msgp.Uint64Size + 11 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *processMemoryInfoStat) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "rss":
+			z.RSS, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "RSS")
+				return
+			}
+		case "vms":
+			z.VMS, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "VMS")
+				return
+			}
+		case "hwm":
+			z.HWM, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "HWM")
+				return
+			}
+		case "data":
+			z.Data, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Data")
+				return
+			}
+		case "stack":
+			z.Stack, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Stack")
+				return
+			}
+		case "locked":
+			z.Locked, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Locked")
+				return
+			}
+		case "swap":
+			z.Swap, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Swap")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *processMemoryInfoStat) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 7
+	// write "rss"
+	err = en.Append(0x87, 0xa3, 0x72, 0x73, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.RSS)
+	if err != nil {
+		err = msgp.WrapError(err, "RSS")
+		return
+	}
+	// write "vms"
+	err = en.Append(0xa3, 0x76, 0x6d, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.VMS)
+	if err != nil {
+		err = msgp.WrapError(err, "VMS")
+		return
+	}
+	// write "hwm"
+	err = en.Append(0xa3, 0x68, 0x77, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.HWM)
+	if err != nil {
+		err = msgp.WrapError(err, "HWM")
+		return
+	}
+	// write "data"
+	err = en.Append(0xa4, 0x64, 0x61, 0x74, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Data)
+	if err != nil {
+		err = msgp.WrapError(err, "Data")
+		return
+	}
+	// write "stack"
+	err = en.Append(0xa5, 0x73, 0x74, 0x61, 0x63, 0x6b)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Stack)
+	if err != nil {
+		err = msgp.WrapError(err, "Stack")
+		return
+	}
+	// write "locked"
+	err = en.Append(0xa6, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Locked)
+	if err != nil {
+		err = msgp.WrapError(err, "Locked")
+		return
+	}
+	// write "swap"
+	err = en.Append(0xa4, 0x73, 0x77, 0x61, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Swap)
+	if err != nil {
+		err = msgp.WrapError(err, "Swap")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *processMemoryInfoStat) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 7
+	// string "rss"
+	o = append(o, 0x87, 0xa3, 0x72, 0x73, 0x73)
+	o = msgp.AppendUint64(o, z.RSS)
+	// string "vms"
+	o = append(o, 0xa3, 0x76, 0x6d, 0x73)
+	o = msgp.AppendUint64(o, z.VMS)
+	// string "hwm"
+	o = append(o, 0xa3, 0x68, 0x77, 0x6d)
+	o = msgp.AppendUint64(o, z.HWM)
+	// string "data"
+	o = append(o, 0xa4, 0x64, 0x61, 0x74, 0x61)
+	o = msgp.AppendUint64(o, z.Data)
+	// string "stack"
+	o = append(o, 0xa5, 0x73, 0x74, 0x61, 0x63, 0x6b)
+	o = msgp.AppendUint64(o, z.Stack)
+	// string "locked"
+	o = append(o, 0xa6, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64)
+	o = msgp.AppendUint64(o, z.Locked)
+	// string "swap"
+	o = append(o, 0xa4, 0x73, 0x77, 0x61, 0x70)
+	o = msgp.AppendUint64(o, z.Swap)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *processMemoryInfoStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "rss":
+			z.RSS, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RSS")
+				return
+			}
+		case "vms":
+			z.VMS, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VMS")
+				return
+			}
+		case "hwm":
+			z.HWM, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "HWM")
+				return
+			}
+		case "data":
+			z.Data, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Data")
+				return
+			}
+		case "stack":
+			z.Stack, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Stack")
+				return
+			}
+		case "locked":
+			z.Locked, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Locked")
+				return
+			}
+		case "swap":
+			z.Swap, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Swap")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *processMemoryInfoStat) Msgsize() (s int) {
+	s = 1 + 4 + msgp.Uint64Size + 4 + msgp.Uint64Size + 4 + msgp.Uint64Size + 5 + msgp.Uint64Size + 6 + msgp.Uint64Size + 7 + msgp.Uint64Size + 5 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *processNumCtxSwitchesStat) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "voluntary":
+			z.Voluntary, err = dc.ReadInt64()
 			if err != nil {
-				err = msgp.WrapError(err, "Load1")
+				err = msgp.WrapError(err, "Voluntary")
 				return
 			}
-		case "load5":
-			z.Load5, err = dc.ReadFloat64()
+		case "involuntary":
+			z.Involuntary, err = dc.ReadInt64()
 			if err != nil {
-				err = msgp.WrapError(err, "Load5")
+				err = msgp.WrapError(err, "Involuntary")
 				return
 			}
-		case "load15":
-			z.Load15, err = dc.ReadFloat64()
+		default:
+			err = dc.Skip()
 			if err != nil {
-				err = msgp.WrapError(err, "Load15")
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z processNumCtxSwitchesStat) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "voluntary"
+	err = en.Append(0x82, 0xa9, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Voluntary)
+	if err != nil {
+		err = msgp.WrapError(err, "Voluntary")
+		return
+	}
+	// write "involuntary"
+	err = en.Append(0xab, 0x69, 0x6e, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Involuntary)
+	if err != nil {
+		err = msgp.WrapError(err, "Involuntary")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z processNumCtxSwitchesStat) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "voluntary"
+	o = append(o, 0x82, 0xa9, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79)
+	o = msgp.AppendInt64(o, z.Voluntary)
+	// string "involuntary"
+	o = append(o, 0xab, 0x69, 0x6e, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79)
+	o = msgp.AppendInt64(o, z.Involuntary)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *processNumCtxSwitchesStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "voluntary":
+			z.Voluntary, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Voluntary")
+				return
+			}
+		case "involuntary":
+			z.Involuntary, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Involuntary")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z processNumCtxSwitchesStat) Msgsize() (s int) {
+	s = 1 + 10 + msgp.Int64Size + 12 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *processPageFaultsStat) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "minorFaults":
+			z.MinorFaults, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "MinorFaults")
+				return
+			}
+		case "majorFaults":
+			z.MajorFaults, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "MajorFaults")
+				return
+			}
+		case "childMinorFaults":
+			z.ChildMinorFaults, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ChildMinorFaults")
+				return
+			}
+		case "childMajorFaults":
+			z.ChildMajorFaults, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ChildMajorFaults")
 				return
 			}
 		default:
@@ -407,59 +1706,72 @@ func (z *loadAvgStat) DecodeMsg(dc *msgp.Reader) (err error) {
 }
 
 // EncodeMsg implements msgp.Encodable
-func (z loadAvgStat) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 3
-	// write "load1"
-	err = en.Append(0x83, 0xa5, 0x6c, 0x6f, 0x61, 0x64, 0x31)
+func (z *processPageFaultsStat) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "minorFaults"
+	err = en.Append(0x84, 0xab, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73)
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.Load1)
+	err = en.WriteUint64(z.MinorFaults)
 	if err != nil {
-		err = msgp.WrapError(err, "Load1")
+		err = msgp.WrapError(err, "MinorFaults")
 		return
 	}
-	// write "load5"
-	err = en.Append(0xa5, 0x6c, 0x6f, 0x61, 0x64, 0x35)
+	// write "majorFaults"
+	err = en.Append(0xab, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73)
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.Load5)
+	err = en.WriteUint64(z.MajorFaults)
 	if err != nil {
-		err = msgp.WrapError(err, "Load5")
+		err = msgp.WrapError(err, "MajorFaults")
 		return
 	}
-	// write "load15"
-	err = en.Append(0xa6, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35)
+	// write "childMinorFaults"
+	err = en.Append(0xb0, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73)
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.Load15)
+	err = en.WriteUint64(z.ChildMinorFaults)
 	if err != nil {
-		err = msgp.WrapError(err, "Load15")
+		err = msgp.WrapError(err, "ChildMinorFaults")
+		return
+	}
+	// write "childMajorFaults"
+	err = en.Append(0xb0, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ChildMajorFaults)
+	if err != nil {
+		err = msgp.WrapError(err, "ChildMajorFaults")
 		return
 	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z loadAvgStat) MarshalMsg(b []byte) (o []byte, err error) {
+func (z *processPageFaultsStat) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 3
-	// string "load1"
-	o = append(o, 0x83, 0xa5, 0x6c, 0x6f, 0x61, 0x64, 0x31)
-	o = msgp.AppendFloat64(o, z.Load1)
-	// string "load5"
-	o = append(o, 0xa5, 0x6c, 0x6f, 0x61, 0x64, 0x35)
-	o = msgp.AppendFloat64(o, z.Load5)
-	// string "load15"
-	o = append(o, 0xa6, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x35)
-	o = msgp.AppendFloat64(o, z.Load15)
+	// map header, size 4
+	// string "minorFaults"
+	o = append(o, 0x84, 0xab, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73)
+	o = msgp.AppendUint64(o, z.MinorFaults)
+	// string "majorFaults"
+	o = append(o, 0xab, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73)
+	o = msgp.AppendUint64(o, z.MajorFaults)
+	// string "childMinorFaults"
+	o = append(o, 0xb0, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73)
+	o = msgp.AppendUint64(o, z.ChildMinorFaults)
+	// string "childMajorFaults"
+	o = append(o, 0xb0, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73)
+	o = msgp.AppendUint64(o, z.ChildMajorFaults)
 	return
 }
 
 // UnmarshalMsg implements msgp.Unmarshaler
-func (z *loadAvgStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
+func (z *processPageFaultsStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
 	var zb0001 uint32
@@ -476,22 +1788,28 @@ func (z *loadAvgStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
-		case "load1":
-			z.Load1, bts, err = msgp.ReadFloat64Bytes(bts)
+		case "minorFaults":
+			z.MinorFaults, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Load1")
+				err = msgp.WrapError(err, "MinorFaults")
 				return
 			}
-		case "load5":
-			z.Load5, bts, err = msgp.ReadFloat64Bytes(bts)
+		case "majorFaults":
+			z.MajorFaults, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Load5")
+				err = msgp.WrapError(err, "MajorFaults")
 				return
 			}
-		case "load15":
-			z.Load15, bts, err = msgp.ReadFloat64Bytes(bts)
+		case "childMinorFaults":
+			z.ChildMinorFaults, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Load15")
+				err = msgp.WrapError(err, "ChildMinorFaults")
+				return
+			}
+		case "childMajorFaults":
+			z.ChildMajorFaults, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ChildMajorFaults")
 				return
 			}
 		default:
@@ -507,8 +1825,8 @@ func (z *loadAvgStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z loadAvgStat) Msgsize() (s int) {
-	s = 1 + 6 + msgp.Float64Size + 6 + msgp.Float64Size + 7 + msgp.Float64Size
+func (z *processPageFaultsStat) Msgsize() (s int) {
+	s = 1 + 12 + msgp.Uint64Size + 12 + msgp.Uint64Size + 17 + msgp.Uint64Size + 17 + msgp.Uint64Size
 	return
 }
 
@@ -0,0 +1,245 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"sort"
+)
+
+// HealthDiff - differences between two health reports
+type HealthDiff struct {
+	NodesAdded   []string            `json:"nodes_added,omitempty"`
+	NodesRemoved []string            `json:"nodes_removed,omitempty"`
+	Capacity     []CapacityDelta     `json:"capacity,omitempty"`
+	Drives       []DriveStatusChange `json:"drives,omitempty"`
+	Servers      []ServerStateChange `json:"servers,omitempty"`
+}
+
+// CapacityDelta - change of the drive capacity of a node in bytes
+type CapacityDelta struct {
+	Addr       string `json:"addr"`
+	TotalDelta int64  `json:"total_delta"`
+	FreeDelta  int64  `json:"free_delta"`
+}
+
+// DriveStatusChange - change of the status of a drive, an empty
+// status means the drive is not present in the report.
+type DriveStatusChange struct {
+	Addr   string `json:"addr"`
+	Drive  string `json:"drive"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// ServerStateChange - change of the state or version of a server
+type ServerStateChange struct {
+	Endpoint      string `json:"endpoint"`
+	StateBefore   string `json:"state_before,omitempty"`
+	StateAfter    string `json:"state_after,omitempty"`
+	VersionBefore string `json:"version_before,omitempty"`
+	VersionAfter  string `json:"version_after,omitempty"`
+}
+
+// IsEmpty returns true if no differences were found.
+func (d HealthDiff) IsEmpty() bool {
+	return len(d.NodesAdded) == 0 && len(d.NodesRemoved) == 0 &&
+		len(d.Capacity) == 0 && len(d.Drives) == 0 && len(d.Servers) == 0
+}
+
+// Diff returns the differences of the sys and minio sections from info to
+// other: nodes that appeared or disappeared, drive capacity changes per
+// node, drives whose state changed, and servers whose state or version
+// changed. Timestamps are not compared.
+func (info HealthInfoV2) Diff(other HealthInfoV2) HealthDiff {
+	var diff HealthDiff
+
+	before, after := info.nodeAddrs(), other.nodeAddrs()
+	for addr := range after {
+		if _, ok := before[addr]; !ok {
+			diff.NodesAdded = append(diff.NodesAdded, addr)
+		}
+	}
+	for addr := range before {
+		if _, ok := after[addr]; !ok {
+			diff.NodesRemoved = append(diff.NodesRemoved, addr)
+		}
+	}
+	sort.Strings(diff.NodesAdded)
+	sort.Strings(diff.NodesRemoved)
+
+	capBefore, capAfter := info.nodeCapacity(), other.nodeCapacity()
+	for addr := range unionKeys(capBefore, capAfter) {
+		b, a := capBefore[addr], capAfter[addr]
+		if a != b {
+			diff.Capacity = append(diff.Capacity, CapacityDelta{
+				Addr:       addr,
+				TotalDelta: int64(a.total) - int64(b.total),
+				FreeDelta:  int64(a.free) - int64(b.free),
+			})
+		}
+	}
+	sort.Slice(diff.Capacity, func(i, j int) bool {
+		return diff.Capacity[i].Addr < diff.Capacity[j].Addr
+	})
+
+	drvBefore, drvAfter := info.driveStates(), other.driveStates()
+	for k := range unionKeys(drvBefore, drvAfter) {
+		if b, a := drvBefore[k], drvAfter[k]; a != b {
+			diff.Drives = append(diff.Drives, DriveStatusChange{Addr: k.addr, Drive: k.drive, Before: b, After: a})
+		}
+	}
+	sort.Slice(diff.Drives, func(i, j int) bool {
+		if diff.Drives[i].Addr != diff.Drives[j].Addr {
+			return diff.Drives[i].Addr < diff.Drives[j].Addr
+		}
+		return diff.Drives[i].Drive < diff.Drives[j].Drive
+	})
+
+	srvBefore, srvAfter := info.serverInfos(), other.serverInfos()
+	for endpoint := range unionKeys(srvBefore, srvAfter) {
+		b, a := srvBefore[endpoint], srvAfter[endpoint]
+		if b.State != a.State || b.Version != a.Version {
+			diff.Servers = append(diff.Servers, ServerStateChange{
+				Endpoint:      endpoint,
+				StateBefore:   b.State,
+				StateAfter:    a.State,
+				VersionBefore: b.Version,
+				VersionAfter:  a.Version,
+			})
+		}
+	}
+	sort.Slice(diff.Servers, func(i, j int) bool {
+		return diff.Servers[i].Endpoint < diff.Servers[j].Endpoint
+	})
+
+	return diff
+}
+
+// nodeAddrs returns the addresses of all nodes present in the report.
+func (info HealthInfoV2) nodeAddrs() map[string]struct{} {
+	addrs := make(map[string]struct{})
+	add := func(addr string) {
+		if addr != "" {
+			addrs[addr] = struct{}{}
+		}
+	}
+	sys := info.Sys
+	for _, v := range sys.CPUInfo {
+		add(v.Addr)
+	}
+	for _, v := range sys.Partitions {
+		add(v.Addr)
+	}
+	for _, v := range sys.OSInfo {
+		add(v.Addr)
+	}
+	for _, v := range sys.MemInfo {
+		add(v.Addr)
+	}
+	for _, v := range sys.ProcInfo {
+		add(v.Addr)
+	}
+	for _, v := range sys.NetInfo {
+		add(v.Addr)
+	}
+	for _, v := range sys.SysErrs {
+		add(v.Addr)
+	}
+	for _, v := range sys.SysServices {
+		add(v.Addr)
+	}
+	for _, v := range sys.SysConfig {
+		add(v.Addr)
+	}
+	for _, v := range sys.ProductInfo {
+		add(v.Addr)
+	}
+	for _, v := range info.Minio.Info.Servers {
+		add(v.Endpoint)
+	}
+	return addrs
+}
+
+type nodeCapacity struct {
+	total, free uint64
+}
+
+// nodeCapacity returns the drive capacity of each node,
+// partitions reporting an error are skipped.
+func (info HealthInfoV2) nodeCapacity() map[string]nodeCapacity {
+	caps := make(map[string]nodeCapacity)
+	for _, parts := range info.Sys.Partitions {
+		c := caps[parts.Addr]
+		for _, p := range parts.Partitions {
+			if p.Error == "" {
+				c.total += p.SpaceTotal
+				c.free += p.SpaceFree
+			}
+		}
+		caps[parts.Addr] = c
+	}
+	return caps
+}
+
+type driveKey struct {
+	addr, drive string
+}
+
+// driveStates returns the state of each drive reported by the servers
+// and the status ("ok" or the error) of each partition of the nodes.
+func (info HealthInfoV2) driveStates() map[driveKey]string {
+	states := make(map[driveKey]string)
+	for _, srv := range info.Minio.Info.Servers {
+		for _, d := range srv.Drives {
+			states[driveKey{addr: srv.Endpoint, drive: d.Endpoint}] = d.State
+		}
+	}
+	for _, parts := range info.Sys.Partitions {
+		for _, p := range parts.Partitions {
+			status := "ok"
+			if p.Error != "" {
+				status = p.Error
+			}
+			states[driveKey{addr: parts.Addr, drive: p.Device}] = status
+		}
+	}
+	return states
+}
+
+// serverInfos returns the server info of each endpoint.
+func (info HealthInfoV2) serverInfos() map[string]ServerInfo {
+	servers := make(map[string]ServerInfo, len(info.Minio.Info.Servers))
+	for _, srv := range info.Minio.Info.Servers {
+		servers[srv.Endpoint] = srv
+	}
+	return servers
+}
+
+// unionKeys returns the union of the keys of a and b.
+func unionKeys[K comparable, V any](a, b map[K]V) map[K]struct{} {
+	keys := make(map[K]struct{}, len(a)+len(b))
+	for k := range a {
+		keys[k] = struct{}{}
+	}
+	for k := range b {
+		keys[k] = struct{}{}
+	}
+	return keys
+}
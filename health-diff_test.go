@@ -0,0 +1,75 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHealthInfoV2Diff(t *testing.T) {
+	before := HealthInfoV2{
+		TimeStamp: time.Now().Add(-time.Hour),
+		Sys: SysInfo{
+			CPUInfo: []CPUs{{NodeCommon: NodeCommon{Addr: "node1:9000"}}, {NodeCommon: NodeCommon{Addr: "node2:9000"}}},
+			Partitions: []Partitions{{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				Partitions: []Partition{{Device: "/dev/sda", SpaceTotal: 1000, SpaceFree: 600}},
+			}},
+		},
+		Minio: MinioHealthInfo{Info: MinioInfo{Servers: []ServerInfo{
+			{Endpoint: "node1:9000", State: "online", Version: "v1", Drives: []Disk{{Endpoint: "http://node1:9000/mnt/disk1", State: "ok"}}},
+		}}},
+	}
+	after := HealthInfoV2{
+		TimeStamp: time.Now(),
+		Sys: SysInfo{
+			CPUInfo: []CPUs{{NodeCommon: NodeCommon{Addr: "node1:9000"}}, {NodeCommon: NodeCommon{Addr: "node3:9000"}}},
+			Partitions: []Partitions{{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				Partitions: []Partition{{Device: "/dev/sda", SpaceTotal: 1000, SpaceFree: 400}},
+			}},
+		},
+		Minio: MinioHealthInfo{Info: MinioInfo{Servers: []ServerInfo{
+			{Endpoint: "node1:9000", State: "online", Version: "v2", Drives: []Disk{{Endpoint: "http://node1:9000/mnt/disk1", State: "faulty"}}},
+		}}},
+	}
+
+	expected := HealthDiff{
+		NodesAdded:   []string{"node3:9000"},
+		NodesRemoved: []string{"node2:9000"},
+		Capacity:     []CapacityDelta{{Addr: "node1:9000", FreeDelta: -200}},
+		Drives:       []DriveStatusChange{{Addr: "node1:9000", Drive: "http://node1:9000/mnt/disk1", Before: "ok", After: "faulty"}},
+		Servers:      []ServerStateChange{{Endpoint: "node1:9000", StateBefore: "online", StateAfter: "online", VersionBefore: "v1", VersionAfter: "v2"}},
+	}
+	diff := before.Diff(after)
+	if !reflect.DeepEqual(diff, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, diff)
+	}
+	if _, err := json.Marshal(diff); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff = before.Diff(before); !diff.IsEmpty() {
+		t.Fatalf("expected no differences, got %+v", diff)
+	}
+}
@@ -0,0 +1,277 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"fmt"
+
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+// HealthEventSeverity - severity of a health event
+type HealthEventSeverity string
+
+// Health event severities
+const (
+	HealthEventInfo     HealthEventSeverity = "info"
+	HealthEventWarning  HealthEventSeverity = "warning"
+	HealthEventCritical HealthEventSeverity = "critical"
+)
+
+// Health event components
+const (
+	HealthComponentCluster  = "cluster"
+	HealthComponentCPU      = "cpu"
+	HealthComponentDrive    = "drive"
+	HealthComponentOS       = "os"
+	HealthComponentMem      = "mem"
+	HealthComponentProcess  = "process"
+	HealthComponentNet      = "net"
+	HealthComponentSys      = "sys"
+	HealthComponentMinio    = "minio"
+	HealthComponentSensor   = "sensor"
+	HealthComponentCapacity = "capacity"
+	HealthComponentPerf     = "perf"
+)
+
+// Thresholds, as a fraction of the total, below which
+// free capacity or available memory raise events.
+const (
+	healthFreeWarnThreshold     = 0.10
+	healthFreeCriticalThreshold = 0.05
+)
+
+// HealthEvent - a problem detected in a health report
+type HealthEvent struct {
+	Severity  HealthEventSeverity `json:"severity"`
+	Component string              `json:"component"`
+	Addr      string              `json:"addr,omitempty"`
+	Message   string              `json:"message"`
+}
+
+// Events returns the problems detected in the health report as a flat
+// list: collection errors, low drive capacity, overheating sensors, low
+// available memory and system configuration issues affecting MinIO.
+func (info HealthInfoV2) Events() []HealthEvent {
+	var events []HealthEvent
+	add := func(severity HealthEventSeverity, component, addr, format string, args ...interface{}) {
+		events = append(events, HealthEvent{
+			Severity:  severity,
+			Component: component,
+			Addr:      addr,
+			Message:   fmt.Sprintf(format, args...),
+		})
+	}
+	nodeErr := func(component string, n NodeCommon) {
+		if n.Error != "" {
+			add(HealthEventWarning, component, n.Addr, "failed to collect %s info: %s", component, n.Error)
+		}
+	}
+
+	if info.Error != "" {
+		add(HealthEventCritical, HealthComponentCluster, "", "health info collection failed: %s", info.Error)
+	}
+	if info.Minio.Error != "" {
+		add(HealthEventWarning, HealthComponentMinio, "", "failed to collect minio info: %s", info.Minio.Error)
+	}
+
+	sys := info.Sys
+	for _, c := range sys.CPUInfo {
+		nodeErr(HealthComponentCPU, c.NodeCommon)
+	}
+	for _, p := range sys.ProcInfo {
+		nodeErr(HealthComponentProcess, p.NodeCommon)
+	}
+	for _, n := range sys.NetInfo {
+		nodeErr(HealthComponentNet, n.NodeCommon)
+	}
+	for _, c := range sys.SysConfig {
+		nodeErr(HealthComponentSys, c.NodeCommon)
+	}
+	for _, s := range sys.SysServices {
+		nodeErr(HealthComponentSys, s.NodeCommon)
+		for _, srv := range s.Services {
+			if srv.Name == SrvSELinux && srv.Status == "enforcing" {
+				add(HealthEventInfo, HealthComponentSys, s.Addr, "selinux is enforcing")
+			}
+		}
+	}
+	for _, e := range sys.SysErrs {
+		nodeErr(HealthComponentSys, e.NodeCommon)
+		for _, msg := range e.Errors {
+			add(HealthEventWarning, HealthComponentSys, e.Addr, "%s", msg)
+		}
+	}
+
+	for _, parts := range sys.Partitions {
+		nodeErr(HealthComponentDrive, parts.NodeCommon)
+		for _, p := range parts.Partitions {
+			if p.Error != "" {
+				add(HealthEventWarning, HealthComponentDrive, parts.Addr, "%s: %s", p.Device, p.Error)
+				continue
+			}
+			if p.SpaceTotal == 0 {
+				continue
+			}
+			if severity, ok := freeSeverity(p.SpaceFree, p.SpaceTotal); ok {
+				add(severity, HealthComponentCapacity, parts.Addr, "%s: only %.1f%% free space left on %s",
+					p.Device, float64(p.SpaceFree)/float64(p.SpaceTotal)*100, p.Mountpoint)
+			}
+		}
+	}
+
+	for _, osInfo := range sys.OSInfo {
+		nodeErr(HealthComponentOS, osInfo.NodeCommon)
+		for _, sensor := range osInfo.Sensors {
+			if severity, ok := sensorSeverity(sensor); ok {
+				add(severity, HealthComponentSensor, osInfo.Addr, "%s: temperature %.1f exceeds threshold",
+					sensor.SensorKey, sensor.Temperature)
+			}
+		}
+	}
+
+	for _, m := range sys.MemInfo {
+		nodeErr(HealthComponentMem, m.NodeCommon)
+		if m.Error != "" || m.Total == 0 {
+			continue
+		}
+		if severity, ok := freeSeverity(m.Available, m.Total); ok {
+			add(severity, HealthComponentMem, m.Addr, "only %.1f%% memory available",
+				float64(m.Available)/float64(m.Total)*100)
+		}
+	}
+
+	return events
+}
+
+// NodeError - an error reported in a health report, attributed to
+// the node and the component (one of HealthComponent*) it belongs to.
+type NodeError struct {
+	Addr    string `json:"addr,omitempty"`
+	Scope   string `json:"scope"`
+	Message string `json:"message"`
+}
+
+// AllErrors returns all errors reported in the health report. Errors
+// of individual drives and peers are prefixed by the drive or peer.
+func (info HealthInfoV2) AllErrors() []NodeError {
+	var errs []NodeError
+	add := func(scope, addr, msg string) {
+		if msg != "" {
+			errs = append(errs, NodeError{Addr: addr, Scope: scope, Message: msg})
+		}
+	}
+
+	add(HealthComponentCluster, "", info.Error)
+	add(HealthComponentMinio, "", info.Minio.Error)
+	add(HealthComponentMinio, "", info.Minio.Config.Error)
+	if info.Minio.Replication != nil {
+		add(HealthComponentMinio, "", info.Minio.Replication.Error)
+	}
+
+	sys := info.Sys
+	for _, c := range sys.CPUInfo {
+		add(HealthComponentCPU, c.Addr, c.Error)
+	}
+	for _, parts := range sys.Partitions {
+		add(HealthComponentDrive, parts.Addr, parts.Error)
+		for _, p := range parts.Partitions {
+			if p.Error != "" {
+				add(HealthComponentDrive, parts.Addr, p.Device+": "+p.Error)
+			}
+		}
+	}
+	for _, o := range sys.OSInfo {
+		add(HealthComponentOS, o.Addr, o.Error)
+	}
+	for _, m := range sys.MemInfo {
+		add(HealthComponentMem, m.Addr, m.Error)
+	}
+	for _, p := range sys.ProcInfo {
+		add(HealthComponentProcess, p.Addr, p.Error)
+	}
+	for _, n := range sys.NetInfo {
+		add(HealthComponentNet, n.Addr, n.Error)
+	}
+	for _, e := range sys.SysErrs {
+		add(HealthComponentSys, e.Addr, e.Error)
+		for _, msg := range e.Errors {
+			add(HealthComponentSys, e.Addr, msg)
+		}
+	}
+	for _, srv := range sys.SysServices {
+		add(HealthComponentSys, srv.Addr, srv.Error)
+	}
+	for _, c := range sys.SysConfig {
+		add(HealthComponentSys, c.Addr, c.Error)
+	}
+	for _, p := range sys.ProductInfo {
+		add(HealthComponentSys, p.Addr, p.Error)
+	}
+	add(HealthComponentSys, "", sys.KubernetesInfo.Error)
+
+	for _, d := range info.Perf.Drives {
+		add(HealthComponentPerf, d.Addr, d.Error)
+		for _, perfs := range [][]DrivePerfInfo{d.SerialPerf, d.ParallelPerf} {
+			for _, p := range perfs {
+				if p.Error != "" {
+					add(HealthComponentPerf, d.Addr, p.Path+": "+p.Error)
+				}
+			}
+		}
+	}
+	netErrs := func(n NetPerfInfo) {
+		add(HealthComponentPerf, n.Addr, n.Error)
+		for _, peer := range n.RemotePeers {
+			if peer.Error != "" {
+				add(HealthComponentPerf, n.Addr, peer.Addr+": "+peer.Error)
+			}
+		}
+	}
+	for _, n := range info.Perf.Net {
+		netErrs(n)
+	}
+	netErrs(info.Perf.NetParallel)
+	return errs
+}
+
+// freeSeverity returns the severity of free being left out of total.
+func freeSeverity(free, total uint64) (HealthEventSeverity, bool) {
+	ratio := float64(free) / float64(total)
+	switch {
+	case ratio < healthFreeCriticalThreshold:
+		return HealthEventCritical, true
+	case ratio < healthFreeWarnThreshold:
+		return HealthEventWarning, true
+	}
+	return "", false
+}
+
+// sensorSeverity returns the severity of a sensor temperature reading.
+// Thresholds reported as zero are ignored.
+func sensorSeverity(t host.TemperatureStat) (HealthEventSeverity, bool) {
+	switch {
+	case t.Critical > 0 && t.Temperature >= t.Critical:
+		return HealthEventCritical, true
+	case t.High > 0 && t.Temperature >= t.High:
+		return HealthEventWarning, true
+	}
+	return "", false
+}
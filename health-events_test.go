@@ -0,0 +1,119 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+func TestHealthInfoV2Events(t *testing.T) {
+	info := HealthInfoV2{
+		Sys: SysInfo{
+			CPUInfo: []CPUs{{NodeCommon: NodeCommon{Addr: "node1:9000", Error: "cpu info unavailable"}}},
+			Partitions: []Partitions{{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				Partitions: []Partition{
+					{Device: "/dev/sda", Mountpoint: "/mnt/disk1", SpaceTotal: 100, SpaceFree: 50},
+					{Device: "/dev/sdb", Mountpoint: "/mnt/disk2", SpaceTotal: 100, SpaceFree: 8},
+					{Device: "/dev/sdc", Mountpoint: "/mnt/disk3", SpaceTotal: 100, SpaceFree: 2},
+				},
+			}},
+			OSInfo: []OSInfo{{
+				NodeCommon: NodeCommon{Addr: "node2:9000"},
+				Sensors: []host.TemperatureStat{
+					{SensorKey: "cpu_thermal", Temperature: 50, High: 80, Critical: 95},
+					{SensorKey: "nvme_composite", Temperature: 85, High: 80, Critical: 95},
+					{SensorKey: "acpitz", Temperature: 99},
+				},
+			}},
+			MemInfo: []MemInfo{
+				{NodeCommon: NodeCommon{Addr: "node1:9000"}, Total: 100, Available: 50},
+				{NodeCommon: NodeCommon{Addr: "node2:9000"}, Total: 100, Available: 3},
+			},
+			SysErrs: []SysErrors{{NodeCommon: NodeCommon{Addr: "node2:9000"}, Errors: []string{SysErrAuditEnabled}}},
+		},
+	}
+
+	expected := []HealthEvent{
+		{Severity: HealthEventWarning, Component: HealthComponentCPU, Addr: "node1:9000"},
+		{Severity: HealthEventWarning, Component: HealthComponentSys, Addr: "node2:9000"},
+		{Severity: HealthEventWarning, Component: HealthComponentCapacity, Addr: "node1:9000"},
+		{Severity: HealthEventCritical, Component: HealthComponentCapacity, Addr: "node1:9000"},
+		{Severity: HealthEventWarning, Component: HealthComponentSensor, Addr: "node2:9000"},
+		{Severity: HealthEventCritical, Component: HealthComponentMem, Addr: "node2:9000"},
+	}
+
+	events := info.Events()
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %d: %v", len(expected), len(events), events)
+	}
+	for i, e := range events {
+		if e.Severity != expected[i].Severity || e.Component != expected[i].Component || e.Addr != expected[i].Addr {
+			t.Errorf("event %d: expected %v, got %v", i, expected[i], e)
+		}
+		if e.Message == "" {
+			t.Errorf("event %d: expected a message", i)
+		}
+	}
+
+	if events := (HealthInfoV2{}).Events(); len(events) != 0 {
+		t.Fatalf("expected no events for an empty report, got %v", events)
+	}
+}
+
+func TestHealthInfoV2AllErrors(t *testing.T) {
+	info := HealthInfoV2{
+		Error: "partial report",
+		Sys: SysInfo{
+			CPUInfo: []CPUs{{NodeCommon: NodeCommon{Addr: "node1:9000", Error: "cpu: permission denied"}}},
+			Partitions: []Partitions{{
+				NodeCommon: NodeCommon{Addr: "node2:9000"},
+				Partitions: []Partition{{Device: "/dev/sda", Error: "not mounted"}, {Device: "/dev/sdb"}},
+			}},
+			MemInfo: []MemInfo{{NodeCommon: NodeCommon{Addr: "node1:9000"}}},
+			SysErrs: []SysErrors{{NodeCommon: NodeCommon{Addr: "node3:9000"}, Errors: []string{"xfs: missing"}}},
+		},
+		Perf: PerfInfo{
+			Net: []NetPerfInfo{{
+				NodeCommon:  NodeCommon{Addr: "node1:9000"},
+				RemotePeers: []PeerNetPerfInfo{{NodeCommon: NodeCommon{Addr: "node2:9000", Error: "timeout"}}},
+			}},
+		},
+		Minio: MinioHealthInfo{Config: MinioConfig{Error: "config unavailable"}},
+	}
+
+	want := []NodeError{
+		{Scope: HealthComponentCluster, Message: "partial report"},
+		{Scope: HealthComponentMinio, Message: "config unavailable"},
+		{Addr: "node1:9000", Scope: HealthComponentCPU, Message: "cpu: permission denied"},
+		{Addr: "node2:9000", Scope: HealthComponentDrive, Message: "/dev/sda: not mounted"},
+		{Addr: "node3:9000", Scope: HealthComponentSys, Message: "xfs: missing"},
+		{Addr: "node1:9000", Scope: HealthComponentPerf, Message: "node2:9000: timeout"},
+	}
+	if got := info.AllErrors(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := (HealthInfoV2{}).AllErrors(); len(got) != 0 {
+		t.Fatalf("expected no errors, got %v", got)
+	}
+}
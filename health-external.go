@@ -0,0 +1,127 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+//msgp:tag json
+//go:generate msgp -file $GOFILE
+
+// The types in this file hold replaced third party types or interfaces,
+// which msgp can't reset when omitted, so unlike health.go they are
+// generated without //msgp:clearomitted.
+
+//msgp:ignore NodeCommon
+//msgp:replace NodeCommon with:nodeCommon
+//msgp:replace host.InfoStat with:hostInfoStat
+//msgp:replace host.TemperatureStat with:hostTemperatureStat
+//msgp:replace process.IOCountersStat with:processIOCountersStat
+//msgp:replace process.MemoryInfoStat with:processMemoryInfoStat
+//msgp:shim process.MemoryMapsStat as:string using:processMemoryMapsToMsgp/processMemoryMapsFromMsgp mode:convert
+//msgp:replace process.NumCtxSwitchesStat with:processNumCtxSwitchesStat
+//msgp:replace process.PageFaultsStat with:processPageFaultsStat
+//msgp:replace cpu.TimesStat with:cpuTimesStat
+
+// process.MemoryMapsStat has no fields on some platforms, it is kept in
+// its JSON form when encoded as msgpack.
+
+func processMemoryMapsToMsgp(m process.MemoryMapsStat) (string, error) {
+	b, err := json.Marshal(m)
+	return string(b), err
+}
+
+func processMemoryMapsFromMsgp(s string) (m process.MemoryMapsStat, err error) {
+	err = json.Unmarshal([]byte(s), &m)
+	return m, err
+}
+
+// OSInfo contains operating system's information.
+type OSInfo struct {
+	NodeCommon
+
+	Info    host.InfoStat          `json:"info,omitempty"`
+	Sensors []host.TemperatureStat `json:"sensors,omitempty"`
+}
+
+// ProcInfo contains current process's information.
+type ProcInfo struct {
+	NodeCommon
+
+	PID            int32                      `json:"pid,omitempty"`
+	IsBackground   bool                       `json:"is_background,omitempty"`
+	CPUPercent     float64                    `json:"cpu_percent,omitempty"`
+	ChildrenPIDs   []int32                    `json:"children_pids,omitempty"`
+	CmdLine        string                     `json:"cmd_line,omitempty"`
+	NumConnections int                        `json:"num_connections,omitempty"`
+	CreateTime     int64                      `json:"create_time,omitempty"`
+	CWD            string                     `json:"cwd,omitempty"`
+	ExecPath       string                     `json:"exec_path,omitempty"`
+	GIDs           []int32                    `json:"gids,omitempty"`
+	IOCounters     process.IOCountersStat     `json:"iocounters,omitempty"`
+	IsRunning      bool                       `json:"is_running,omitempty"`
+	MemInfo        process.MemoryInfoStat     `json:"mem_info,omitempty"`
+	MemMaps        []process.MemoryMapsStat   `json:"mem_maps,omitempty"`
+	MemPercent     float32                    `json:"mem_percent,omitempty"`
+	Name           string                     `json:"name,omitempty"`
+	Nice           int32                      `json:"nice,omitempty"`
+	NumCtxSwitches process.NumCtxSwitchesStat `json:"num_ctx_switches,omitempty"`
+	NumFDs         int32                      `json:"num_fds,omitempty"`
+	NumThreads     int32                      `json:"num_threads,omitempty"`
+	PageFaults     process.PageFaultsStat     `json:"page_faults,omitempty"`
+	PPID           int32                      `json:"ppid,omitempty"`
+	Status         string                     `json:"status,omitempty"`
+	TGID           int32                      `json:"tgid,omitempty"`
+	Times          cpu.TimesStat              `json:"times,omitempty"`
+	UIDs           []int32                    `json:"uids,omitempty"`
+	Username       string                     `json:"username,omitempty"`
+}
+
+// MinioConfig contains minio configuration of a node.
+type MinioConfig struct {
+	Error string `json:"error,omitempty"`
+
+	Config interface{} `json:"config,omitempty"`
+}
+
+// MinioInfo contains MinIO server and object storage information.
+type MinioInfo struct {
+	Mode         string           `json:"mode,omitempty"`
+	Domain       []string         `json:"domain,omitempty"`
+	Region       string           `json:"region,omitempty"`
+	SQSARN       []string         `json:"sqsARN,omitempty"`
+	DeploymentID string           `json:"deploymentID,omitempty"`
+	Buckets      Buckets          `json:"buckets,omitempty"`
+	Objects      Objects          `json:"objects,omitempty"`
+	Usage        Usage            `json:"usage,omitempty"`
+	Services     Services         `json:"services,omitempty"`
+	Backend      interface{}      `json:"backend,omitempty"`
+	Servers      []ServerInfo     `json:"servers,omitempty"`
+	TLS          *TLSInfo         `json:"tls"`
+	IsKubernetes *bool            `json:"is_kubernetes"`
+	IsDocker     *bool            `json:"is_docker"`
+	Metrics      *RealtimeMetrics `json:"metrics,omitempty"`
+	TierConfigs  []TierConfig     `json:"tier_configs,omitempty"`
+}
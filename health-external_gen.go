@@ -0,0 +1,2627 @@
+package madmin
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/process"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *MinioConfig) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		case "config":
+			z.Config, err = dc.ReadIntf()
+			if err != nil {
+				err = msgp.WrapError(err, "Config")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z MinioConfig) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "error"
+			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Error)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		}
+		// write "config"
+		err = en.Append(0xa6, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67)
+		if err != nil {
+			return
+		}
+		err = en.WriteIntf(z.Config)
+		if err != nil {
+			err = msgp.WrapError(err, "Config")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z MinioConfig) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "error"
+			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Error)
+		}
+		// string "config"
+		o = append(o, 0xa6, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67)
+		o, err = msgp.AppendIntf(o, z.Config)
+		if err != nil {
+			err = msgp.WrapError(err, "Config")
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *MinioConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		case "config":
+			z.Config, bts, err = msgp.ReadIntfBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Config")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z MinioConfig) Msgsize() (s int) {
+	s = 1 + 6 + msgp.StringPrefixSize + len(z.Error) + 7 + msgp.GuessSize(z.Config)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *MinioInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "mode":
+			z.Mode, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Mode")
+				return
+			}
+		case "domain":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Domain")
+				return
+			}
+			if cap(z.Domain) >= int(zb0002) {
+				z.Domain = (z.Domain)[:zb0002]
+			} else {
+				z.Domain = make([]string, zb0002)
+			}
+			for za0001 := range z.Domain {
+				z.Domain[za0001], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Domain", za0001)
+					return
+				}
+			}
+		case "region":
+			z.Region, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Region")
+				return
+			}
+		case "sqsARN":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "SQSARN")
+				return
+			}
+			if cap(z.SQSARN) >= int(zb0003) {
+				z.SQSARN = (z.SQSARN)[:zb0003]
+			} else {
+				z.SQSARN = make([]string, zb0003)
+			}
+			for za0002 := range z.SQSARN {
+				z.SQSARN[za0002], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "SQSARN", za0002)
+					return
+				}
+			}
+		case "deploymentID":
+			z.DeploymentID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "DeploymentID")
+				return
+			}
+		case "buckets":
+			err = z.Buckets.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Buckets")
+				return
+			}
+		case "objects":
+			err = z.Objects.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Objects")
+				return
+			}
+		case "usage":
+			err = z.Usage.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Usage")
+				return
+			}
+		case "services":
+			err = z.Services.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Services")
+				return
+			}
+		case "backend":
+			z.Backend, err = dc.ReadIntf()
+			if err != nil {
+				err = msgp.WrapError(err, "Backend")
+				return
+			}
+		case "servers":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Servers")
+				return
+			}
+			if cap(z.Servers) >= int(zb0004) {
+				z.Servers = (z.Servers)[:zb0004]
+			} else {
+				z.Servers = make([]ServerInfo, zb0004)
+			}
+			for za0003 := range z.Servers {
+				err = z.Servers[za0003].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Servers", za0003)
+					return
+				}
+			}
+		case "tls":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "TLS")
+					return
+				}
+				z.TLS = nil
+			} else {
+				if z.TLS == nil {
+					z.TLS = new(TLSInfo)
+				}
+				err = z.TLS.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "TLS")
+					return
+				}
+			}
+		case "is_kubernetes":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "IsKubernetes")
+					return
+				}
+				z.IsKubernetes = nil
+			} else {
+				if z.IsKubernetes == nil {
+					z.IsKubernetes = new(bool)
+				}
+				*z.IsKubernetes, err = dc.ReadBool()
+				if err != nil {
+					err = msgp.WrapError(err, "IsKubernetes")
+					return
+				}
+			}
+		case "is_docker":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "IsDocker")
+					return
+				}
+				z.IsDocker = nil
+			} else {
+				if z.IsDocker == nil {
+					z.IsDocker = new(bool)
+				}
+				*z.IsDocker, err = dc.ReadBool()
+				if err != nil {
+					err = msgp.WrapError(err, "IsDocker")
+					return
+				}
+			}
+		case "metrics":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Metrics")
+					return
+				}
+				z.Metrics = nil
+			} else {
+				if z.Metrics == nil {
+					z.Metrics = new(RealtimeMetrics)
+				}
+				err = z.Metrics.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Metrics")
+					return
+				}
+			}
+		case "tier_configs":
+			var zb0005 uint32
+			zb0005, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "TierConfigs")
+				return
+			}
+			if cap(z.TierConfigs) >= int(zb0005) {
+				z.TierConfigs = (z.TierConfigs)[:zb0005]
+			} else {
+				z.TierConfigs = make([]TierConfig, zb0005)
+			}
+			for za0004 := range z.TierConfigs {
+				err = z.TierConfigs[za0004].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "TierConfigs", za0004)
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *MinioInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(16)
+	var zb0001Mask uint16 /* 16 bits */
+	_ = zb0001Mask
+	if z.Mode == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Domain == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Region == "" {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.SQSARN == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.DeploymentID == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Servers == nil {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if z.Metrics == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4000
+	}
+	if z.TierConfigs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8000
+	}
+	// variable map header, size zb0001Len
+	err = en.WriteMapHeader(zb0001Len)
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "mode"
+			err = en.Append(0xa4, 0x6d, 0x6f, 0x64, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Mode)
+			if err != nil {
+				err = msgp.WrapError(err, "Mode")
+				return
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "domain"
+			err = en.Append(0xa6, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Domain)))
+			if err != nil {
+				err = msgp.WrapError(err, "Domain")
+				return
+			}
+			for za0001 := range z.Domain {
+				err = en.WriteString(z.Domain[za0001])
+				if err != nil {
+					err = msgp.WrapError(err, "Domain", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "region"
+			err = en.Append(0xa6, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Region)
+			if err != nil {
+				err = msgp.WrapError(err, "Region")
+				return
+			}
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// write "sqsARN"
+			err = en.Append(0xa6, 0x73, 0x71, 0x73, 0x41, 0x52, 0x4e)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.SQSARN)))
+			if err != nil {
+				err = msgp.WrapError(err, "SQSARN")
+				return
+			}
+			for za0002 := range z.SQSARN {
+				err = en.WriteString(z.SQSARN[za0002])
+				if err != nil {
+					err = msgp.WrapError(err, "SQSARN", za0002)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// write "deploymentID"
+			err = en.Append(0xac, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.DeploymentID)
+			if err != nil {
+				err = msgp.WrapError(err, "DeploymentID")
+				return
+			}
+		}
+		// write "buckets"
+		err = en.Append(0xa7, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73)
+		if err != nil {
+			return
+		}
+		err = z.Buckets.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Buckets")
+			return
+		}
+		// write "objects"
+		err = en.Append(0xa7, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73)
+		if err != nil {
+			return
+		}
+		err = z.Objects.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Objects")
+			return
+		}
+		// write "usage"
+		err = en.Append(0xa5, 0x75, 0x73, 0x61, 0x67, 0x65)
+		if err != nil {
+			return
+		}
+		err = z.Usage.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Usage")
+			return
+		}
+		// write "services"
+		err = en.Append(0xa8, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73)
+		if err != nil {
+			return
+		}
+		err = z.Services.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Services")
+			return
+		}
+		// write "backend"
+		err = en.Append(0xa7, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64)
+		if err != nil {
+			return
+		}
+		err = en.WriteIntf(z.Backend)
+		if err != nil {
+			err = msgp.WrapError(err, "Backend")
+			return
+		}
+		if (zb0001Mask & 0x400) == 0 { // if not omitted
+			// write "servers"
+			err = en.Append(0xa7, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Servers)))
+			if err != nil {
+				err = msgp.WrapError(err, "Servers")
+				return
+			}
+			for za0003 := range z.Servers {
+				err = z.Servers[za0003].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Servers", za0003)
+					return
+				}
+			}
+		}
+		// write "tls"
+		err = en.Append(0xa3, 0x74, 0x6c, 0x73)
+		if err != nil {
+			return
+		}
+		if z.TLS == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.TLS.EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "TLS")
+				return
+			}
+		}
+		// write "is_kubernetes"
+		err = en.Append(0xad, 0x69, 0x73, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73)
+		if err != nil {
+			return
+		}
+		if z.IsKubernetes == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = en.WriteBool(*z.IsKubernetes)
+			if err != nil {
+				err = msgp.WrapError(err, "IsKubernetes")
+				return
+			}
+		}
+		// write "is_docker"
+		err = en.Append(0xa9, 0x69, 0x73, 0x5f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72)
+		if err != nil {
+			return
+		}
+		if z.IsDocker == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = en.WriteBool(*z.IsDocker)
+			if err != nil {
+				err = msgp.WrapError(err, "IsDocker")
+				return
+			}
+		}
+		if (zb0001Mask & 0x4000) == 0 { // if not omitted
+			// write "metrics"
+			err = en.Append(0xa7, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73)
+			if err != nil {
+				return
+			}
+			if z.Metrics == nil {
+				err = en.WriteNil()
+				if err != nil {
+					return
+				}
+			} else {
+				err = z.Metrics.EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Metrics")
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x8000) == 0 { // if not omitted
+			// write "tier_configs"
+			err = en.Append(0xac, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.TierConfigs)))
+			if err != nil {
+				err = msgp.WrapError(err, "TierConfigs")
+				return
+			}
+			for za0004 := range z.TierConfigs {
+				err = z.TierConfigs[za0004].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "TierConfigs", za0004)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *MinioInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(16)
+	var zb0001Mask uint16 /* 16 bits */
+	_ = zb0001Mask
+	if z.Mode == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Domain == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Region == "" {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.SQSARN == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.DeploymentID == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Servers == nil {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if z.Metrics == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4000
+	}
+	if z.TierConfigs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8000
+	}
+	// variable map header, size zb0001Len
+	o = msgp.AppendMapHeader(o, zb0001Len)
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "mode"
+			o = append(o, 0xa4, 0x6d, 0x6f, 0x64, 0x65)
+			o = msgp.AppendString(o, z.Mode)
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "domain"
+			o = append(o, 0xa6, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Domain)))
+			for za0001 := range z.Domain {
+				o = msgp.AppendString(o, z.Domain[za0001])
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "region"
+			o = append(o, 0xa6, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e)
+			o = msgp.AppendString(o, z.Region)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// string "sqsARN"
+			o = append(o, 0xa6, 0x73, 0x71, 0x73, 0x41, 0x52, 0x4e)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.SQSARN)))
+			for za0002 := range z.SQSARN {
+				o = msgp.AppendString(o, z.SQSARN[za0002])
+			}
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// string "deploymentID"
+			o = append(o, 0xac, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44)
+			o = msgp.AppendString(o, z.DeploymentID)
+		}
+		// string "buckets"
+		o = append(o, 0xa7, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73)
+		o, err = z.Buckets.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Buckets")
+			return
+		}
+		// string "objects"
+		o = append(o, 0xa7, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73)
+		o, err = z.Objects.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Objects")
+			return
+		}
+		// string "usage"
+		o = append(o, 0xa5, 0x75, 0x73, 0x61, 0x67, 0x65)
+		o, err = z.Usage.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Usage")
+			return
+		}
+		// string "services"
+		o = append(o, 0xa8, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73)
+		o, err = z.Services.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Services")
+			return
+		}
+		// string "backend"
+		o = append(o, 0xa7, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64)
+		o, err = msgp.AppendIntf(o, z.Backend)
+		if err != nil {
+			err = msgp.WrapError(err, "Backend")
+			return
+		}
+		if (zb0001Mask & 0x400) == 0 { // if not omitted
+			// string "servers"
+			o = append(o, 0xa7, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Servers)))
+			for za0003 := range z.Servers {
+				o, err = z.Servers[za0003].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Servers", za0003)
+					return
+				}
+			}
+		}
+		// string "tls"
+		o = append(o, 0xa3, 0x74, 0x6c, 0x73)
+		if z.TLS == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.TLS.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "TLS")
+				return
+			}
+		}
+		// string "is_kubernetes"
+		o = append(o, 0xad, 0x69, 0x73, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73)
+		if z.IsKubernetes == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o = msgp.AppendBool(o, *z.IsKubernetes)
+		}
+		// string "is_docker"
+		o = append(o, 0xa9, 0x69, 0x73, 0x5f, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72)
+		if z.IsDocker == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o = msgp.AppendBool(o, *z.IsDocker)
+		}
+		if (zb0001Mask & 0x4000) == 0 { // if not omitted
+			// string "metrics"
+			o = append(o, 0xa7, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73)
+			if z.Metrics == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o, err = z.Metrics.MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Metrics")
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x8000) == 0 { // if not omitted
+			// string "tier_configs"
+			o = append(o, 0xac, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.TierConfigs)))
+			for za0004 := range z.TierConfigs {
+				o, err = z.TierConfigs[za0004].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "TierConfigs", za0004)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *MinioInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "mode":
+			z.Mode, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Mode")
+				return
+			}
+		case "domain":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Domain")
+				return
+			}
+			if cap(z.Domain) >= int(zb0002) {
+				z.Domain = (z.Domain)[:zb0002]
+			} else {
+				z.Domain = make([]string, zb0002)
+			}
+			for za0001 := range z.Domain {
+				z.Domain[za0001], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Domain", za0001)
+					return
+				}
+			}
+		case "region":
+			z.Region, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Region")
+				return
+			}
+		case "sqsARN":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SQSARN")
+				return
+			}
+			if cap(z.SQSARN) >= int(zb0003) {
+				z.SQSARN = (z.SQSARN)[:zb0003]
+			} else {
+				z.SQSARN = make([]string, zb0003)
+			}
+			for za0002 := range z.SQSARN {
+				z.SQSARN[za0002], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "SQSARN", za0002)
+					return
+				}
+			}
+		case "deploymentID":
+			z.DeploymentID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DeploymentID")
+				return
+			}
+		case "buckets":
+			bts, err = z.Buckets.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Buckets")
+				return
+			}
+		case "objects":
+			bts, err = z.Objects.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Objects")
+				return
+			}
+		case "usage":
+			bts, err = z.Usage.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Usage")
+				return
+			}
+		case "services":
+			bts, err = z.Services.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Services")
+				return
+			}
+		case "backend":
+			z.Backend, bts, err = msgp.ReadIntfBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Backend")
+				return
+			}
+		case "servers":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Servers")
+				return
+			}
+			if cap(z.Servers) >= int(zb0004) {
+				z.Servers = (z.Servers)[:zb0004]
+			} else {
+				z.Servers = make([]ServerInfo, zb0004)
+			}
+			for za0003 := range z.Servers {
+				bts, err = z.Servers[za0003].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Servers", za0003)
+					return
+				}
+			}
+		case "tls":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.TLS = nil
+			} else {
+				if z.TLS == nil {
+					z.TLS = new(TLSInfo)
+				}
+				bts, err = z.TLS.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TLS")
+					return
+				}
+			}
+		case "is_kubernetes":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.IsKubernetes = nil
+			} else {
+				if z.IsKubernetes == nil {
+					z.IsKubernetes = new(bool)
+				}
+				*z.IsKubernetes, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "IsKubernetes")
+					return
+				}
+			}
+		case "is_docker":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.IsDocker = nil
+			} else {
+				if z.IsDocker == nil {
+					z.IsDocker = new(bool)
+				}
+				*z.IsDocker, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "IsDocker")
+					return
+				}
+			}
+		case "metrics":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Metrics = nil
+			} else {
+				if z.Metrics == nil {
+					z.Metrics = new(RealtimeMetrics)
+				}
+				bts, err = z.Metrics.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Metrics")
+					return
+				}
+			}
+		case "tier_configs":
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TierConfigs")
+				return
+			}
+			if cap(z.TierConfigs) >= int(zb0005) {
+				z.TierConfigs = (z.TierConfigs)[:zb0005]
+			} else {
+				z.TierConfigs = make([]TierConfig, zb0005)
+			}
+			for za0004 := range z.TierConfigs {
+				bts, err = z.TierConfigs[za0004].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TierConfigs", za0004)
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *MinioInfo) Msgsize() (s int) {
+	s = 3 + 5 + msgp.StringPrefixSize + len(z.Mode) + 7 + msgp.ArrayHeaderSize
+	for za0001 := range z.Domain {
+		s += msgp.StringPrefixSize + len(z.Domain[za0001])
+	}
+	s += 7 + msgp.StringPrefixSize + len(z.Region) + 7 + msgp.ArrayHeaderSize
+	for za0002 := range z.SQSARN {
+		s += msgp.StringPrefixSize + len(z.SQSARN[za0002])
+	}
+	s += 13 + msgp.StringPrefixSize + len(z.DeploymentID) + 8 + z.Buckets.Msgsize() + 8 + z.Objects.Msgsize() + 6 + z.Usage.Msgsize() + 9 + z.Services.Msgsize() + 8 + msgp.GuessSize(z.Backend) + 8 + msgp.ArrayHeaderSize
+	for za0003 := range z.Servers {
+		s += z.Servers[za0003].Msgsize()
+	}
+	s += 4
+	if z.TLS == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.TLS.Msgsize()
+	}
+	s += 14
+	if z.IsKubernetes == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.BoolSize
+	}
+	s += 10
+	if z.IsDocker == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.BoolSize
+	}
+	s += 8
+	if z.Metrics == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Metrics.Msgsize()
+	}
+	s += 13 + msgp.ArrayHeaderSize
+	for za0004 := range z.TierConfigs {
+		s += z.TierConfigs[za0004].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *OSInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "info":
+			err = (*hostInfoStat)(&z.Info).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Info")
+				return
+			}
+		case "sensors":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Sensors")
+				return
+			}
+			if cap(z.Sensors) >= int(zb0002) {
+				z.Sensors = (z.Sensors)[:zb0002]
+			} else {
+				z.Sensors = make([]host.TemperatureStat, zb0002)
+			}
+			for za0001 := range z.Sensors {
+				err = (*hostTemperatureStat)(&z.Sensors[za0001]).DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Sensors", za0001)
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *OSInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	if z.Sensors == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		// write "info"
+		err = en.Append(0xa4, 0x69, 0x6e, 0x66, 0x6f)
+		if err != nil {
+			return
+		}
+		err = (*hostInfoStat)(&z.Info).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Info")
+			return
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "sensors"
+			err = en.Append(0xa7, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Sensors)))
+			if err != nil {
+				err = msgp.WrapError(err, "Sensors")
+				return
+			}
+			for za0001 := range z.Sensors {
+				err = (*hostTemperatureStat)(&z.Sensors[za0001]).EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Sensors", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *OSInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	if z.Sensors == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		// string "info"
+		o = append(o, 0xa4, 0x69, 0x6e, 0x66, 0x6f)
+		o, err = (*hostInfoStat)(&z.Info).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Info")
+			return
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "sensors"
+			o = append(o, 0xa7, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Sensors)))
+			for za0001 := range z.Sensors {
+				o, err = (*hostTemperatureStat)(&z.Sensors[za0001]).MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Sensors", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *OSInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "info":
+			bts, err = (*hostInfoStat)(&z.Info).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Info")
+				return
+			}
+		case "sensors":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Sensors")
+				return
+			}
+			if cap(z.Sensors) >= int(zb0002) {
+				z.Sensors = (z.Sensors)[:zb0002]
+			} else {
+				z.Sensors = make([]host.TemperatureStat, zb0002)
+			}
+			for za0001 := range z.Sensors {
+				bts, err = (*hostTemperatureStat)(&z.Sensors[za0001]).UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Sensors", za0001)
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *OSInfo) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 5 + (*hostInfoStat)(&z.Info).Msgsize() + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Sensors {
+		s += (*hostTemperatureStat)(&z.Sensors[za0001]).Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ProcInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "pid":
+			z.PID, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "PID")
+				return
+			}
+		case "is_background":
+			z.IsBackground, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "IsBackground")
+				return
+			}
+		case "cpu_percent":
+			z.CPUPercent, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "CPUPercent")
+				return
+			}
+		case "children_pids":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ChildrenPIDs")
+				return
+			}
+			if cap(z.ChildrenPIDs) >= int(zb0002) {
+				z.ChildrenPIDs = (z.ChildrenPIDs)[:zb0002]
+			} else {
+				z.ChildrenPIDs = make([]int32, zb0002)
+			}
+			for za0001 := range z.ChildrenPIDs {
+				z.ChildrenPIDs[za0001], err = dc.ReadInt32()
+				if err != nil {
+					err = msgp.WrapError(err, "ChildrenPIDs", za0001)
+					return
+				}
+			}
+		case "cmd_line":
+			z.CmdLine, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "CmdLine")
+				return
+			}
+		case "num_connections":
+			z.NumConnections, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "NumConnections")
+				return
+			}
+		case "create_time":
+			z.CreateTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "CreateTime")
+				return
+			}
+		case "cwd":
+			z.CWD, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "CWD")
+				return
+			}
+		case "exec_path":
+			z.ExecPath, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "ExecPath")
+				return
+			}
+		case "gids":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "GIDs")
+				return
+			}
+			if cap(z.GIDs) >= int(zb0003) {
+				z.GIDs = (z.GIDs)[:zb0003]
+			} else {
+				z.GIDs = make([]int32, zb0003)
+			}
+			for za0002 := range z.GIDs {
+				z.GIDs[za0002], err = dc.ReadInt32()
+				if err != nil {
+					err = msgp.WrapError(err, "GIDs", za0002)
+					return
+				}
+			}
+		case "iocounters":
+			err = (*processIOCountersStat)(&z.IOCounters).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "IOCounters")
+				return
+			}
+		case "is_running":
+			z.IsRunning, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "IsRunning")
+				return
+			}
+		case "mem_info":
+			err = (*processMemoryInfoStat)(&z.MemInfo).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "MemInfo")
+				return
+			}
+		case "mem_maps":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "MemMaps")
+				return
+			}
+			if cap(z.MemMaps) >= int(zb0004) {
+				z.MemMaps = (z.MemMaps)[:zb0004]
+			} else {
+				z.MemMaps = make([]process.MemoryMapsStat, zb0004)
+			}
+			for za0003 := range z.MemMaps {
+				{
+					var zb0005 string
+					zb0005, err = dc.ReadString()
+					if err != nil {
+						err = msgp.WrapError(err, "MemMaps", za0003)
+						return
+					}
+					z.MemMaps[za0003], err = processMemoryMapsFromMsgp(zb0005)
+				}
+				if err != nil {
+					err = msgp.WrapError(err, "MemMaps", za0003)
+					return
+				}
+			}
+		case "mem_percent":
+			z.MemPercent, err = dc.ReadFloat32()
+			if err != nil {
+				err = msgp.WrapError(err, "MemPercent")
+				return
+			}
+		case "name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "nice":
+			z.Nice, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "Nice")
+				return
+			}
+		case "num_ctx_switches":
+			err = (*processNumCtxSwitchesStat)(&z.NumCtxSwitches).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NumCtxSwitches")
+				return
+			}
+		case "num_fds":
+			z.NumFDs, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "NumFDs")
+				return
+			}
+		case "num_threads":
+			z.NumThreads, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "NumThreads")
+				return
+			}
+		case "page_faults":
+			err = (*processPageFaultsStat)(&z.PageFaults).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "PageFaults")
+				return
+			}
+		case "ppid":
+			z.PPID, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "PPID")
+				return
+			}
+		case "status":
+			z.Status, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		case "tgid":
+			z.TGID, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "TGID")
+				return
+			}
+		case "times":
+			err = (*cpuTimesStat)(&z.Times).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Times")
+				return
+			}
+		case "uids":
+			var zb0006 uint32
+			zb0006, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "UIDs")
+				return
+			}
+			if cap(z.UIDs) >= int(zb0006) {
+				z.UIDs = (z.UIDs)[:zb0006]
+			} else {
+				z.UIDs = make([]int32, zb0006)
+			}
+			for za0004 := range z.UIDs {
+				z.UIDs[za0004], err = dc.ReadInt32()
+				if err != nil {
+					err = msgp.WrapError(err, "UIDs", za0004)
+					return
+				}
+			}
+		case "username":
+			z.Username, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Username")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *ProcInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(28)
+	var zb0001Mask uint32 /* 28 bits */
+	_ = zb0001Mask
+	if z.PID == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.IsBackground == false {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.CPUPercent == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.ChildrenPIDs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.CmdLine == "" {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.NumConnections == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if z.CreateTime == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if z.CWD == "" {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if z.ExecPath == "" {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if z.GIDs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if z.IsRunning == false {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
+	if z.MemMaps == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4000
+	}
+	if z.MemPercent == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8000
+	}
+	if z.Name == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10000
+	}
+	if z.Nice == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x20000
+	}
+	if z.NumFDs == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x80000
+	}
+	if z.NumThreads == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x100000
+	}
+	if z.PPID == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400000
+	}
+	if z.Status == "" {
+		zb0001Len--
+		zb0001Mask |= 0x800000
+	}
+	if z.TGID == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x1000000
+	}
+	if z.UIDs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4000000
+	}
+	if z.Username == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8000000
+	}
+	// variable map header, size zb0001Len
+	err = en.WriteMapHeader(zb0001Len)
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "pid"
+			err = en.Append(0xa3, 0x70, 0x69, 0x64)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt32(z.PID)
+			if err != nil {
+				err = msgp.WrapError(err, "PID")
+				return
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "is_background"
+			err = en.Append(0xad, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64)
+			if err != nil {
+				return
+			}
+			err = en.WriteBool(z.IsBackground)
+			if err != nil {
+				err = msgp.WrapError(err, "IsBackground")
+				return
+			}
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// write "cpu_percent"
+			err = en.Append(0xab, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74)
+			if err != nil {
+				return
+			}
+			err = en.WriteFloat64(z.CPUPercent)
+			if err != nil {
+				err = msgp.WrapError(err, "CPUPercent")
+				return
+			}
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// write "children_pids"
+			err = en.Append(0xad, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x70, 0x69, 0x64, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.ChildrenPIDs)))
+			if err != nil {
+				err = msgp.WrapError(err, "ChildrenPIDs")
+				return
+			}
+			for za0001 := range z.ChildrenPIDs {
+				err = en.WriteInt32(z.ChildrenPIDs[za0001])
+				if err != nil {
+					err = msgp.WrapError(err, "ChildrenPIDs", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// write "cmd_line"
+			err = en.Append(0xa8, 0x63, 0x6d, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.CmdLine)
+			if err != nil {
+				err = msgp.WrapError(err, "CmdLine")
+				return
+			}
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// write "num_connections"
+			err = en.Append(0xaf, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt(z.NumConnections)
+			if err != nil {
+				err = msgp.WrapError(err, "NumConnections")
+				return
+			}
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// write "create_time"
+			err = en.Append(0xab, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt64(z.CreateTime)
+			if err != nil {
+				err = msgp.WrapError(err, "CreateTime")
+				return
+			}
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// write "cwd"
+			err = en.Append(0xa3, 0x63, 0x77, 0x64)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.CWD)
+			if err != nil {
+				err = msgp.WrapError(err, "CWD")
+				return
+			}
+		}
+		if (zb0001Mask & 0x200) == 0 { // if not omitted
+			// write "exec_path"
+			err = en.Append(0xa9, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.ExecPath)
+			if err != nil {
+				err = msgp.WrapError(err, "ExecPath")
+				return
+			}
+		}
+		if (zb0001Mask & 0x400) == 0 { // if not omitted
+			// write "gids"
+			err = en.Append(0xa4, 0x67, 0x69, 0x64, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.GIDs)))
+			if err != nil {
+				err = msgp.WrapError(err, "GIDs")
+				return
+			}
+			for za0002 := range z.GIDs {
+				err = en.WriteInt32(z.GIDs[za0002])
+				if err != nil {
+					err = msgp.WrapError(err, "GIDs", za0002)
+					return
+				}
+			}
+		}
+		// write "iocounters"
+		err = en.Append(0xaa, 0x69, 0x6f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73)
+		if err != nil {
+			return
+		}
+		err = (*processIOCountersStat)(&z.IOCounters).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "IOCounters")
+			return
+		}
+		if (zb0001Mask & 0x1000) == 0 { // if not omitted
+			// write "is_running"
+			err = en.Append(0xaa, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67)
+			if err != nil {
+				return
+			}
+			err = en.WriteBool(z.IsRunning)
+			if err != nil {
+				err = msgp.WrapError(err, "IsRunning")
+				return
+			}
+		}
+		// write "mem_info"
+		err = en.Append(0xa8, 0x6d, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f)
+		if err != nil {
+			return
+		}
+		err = (*processMemoryInfoStat)(&z.MemInfo).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "MemInfo")
+			return
+		}
+		if (zb0001Mask & 0x4000) == 0 { // if not omitted
+			// write "mem_maps"
+			err = en.Append(0xa8, 0x6d, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.MemMaps)))
+			if err != nil {
+				err = msgp.WrapError(err, "MemMaps")
+				return
+			}
+			for za0003 := range z.MemMaps {
+				var zb0002 string
+				zb0002, err = processMemoryMapsToMsgp(z.MemMaps[za0003])
+				if err != nil {
+					err = msgp.WrapError(err, "MemMaps", za0003)
+					return
+				}
+				err = en.WriteString(zb0002)
+				if err != nil {
+					err = msgp.WrapError(err, "MemMaps", za0003)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x8000) == 0 { // if not omitted
+			// write "mem_percent"
+			err = en.Append(0xab, 0x6d, 0x65, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74)
+			if err != nil {
+				return
+			}
+			err = en.WriteFloat32(z.MemPercent)
+			if err != nil {
+				err = msgp.WrapError(err, "MemPercent")
+				return
+			}
+		}
+		if (zb0001Mask & 0x10000) == 0 { // if not omitted
+			// write "name"
+			err = en.Append(0xa4, 0x6e, 0x61, 0x6d, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Name)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		}
+		if (zb0001Mask & 0x20000) == 0 { // if not omitted
+			// write "nice"
+			err = en.Append(0xa4, 0x6e, 0x69, 0x63, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt32(z.Nice)
+			if err != nil {
+				err = msgp.WrapError(err, "Nice")
+				return
+			}
+		}
+		// write "num_ctx_switches"
+		err = en.Append(0xb0, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x74, 0x78, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73)
+		if err != nil {
+			return
+		}
+		err = (*processNumCtxSwitchesStat)(&z.NumCtxSwitches).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NumCtxSwitches")
+			return
+		}
+		if (zb0001Mask & 0x80000) == 0 { // if not omitted
+			// write "num_fds"
+			err = en.Append(0xa7, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x64, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt32(z.NumFDs)
+			if err != nil {
+				err = msgp.WrapError(err, "NumFDs")
+				return
+			}
+		}
+		if (zb0001Mask & 0x100000) == 0 { // if not omitted
+			// write "num_threads"
+			err = en.Append(0xab, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt32(z.NumThreads)
+			if err != nil {
+				err = msgp.WrapError(err, "NumThreads")
+				return
+			}
+		}
+		// write "page_faults"
+		err = en.Append(0xab, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73)
+		if err != nil {
+			return
+		}
+		err = (*processPageFaultsStat)(&z.PageFaults).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "PageFaults")
+			return
+		}
+		if (zb0001Mask & 0x400000) == 0 { // if not omitted
+			// write "ppid"
+			err = en.Append(0xa4, 0x70, 0x70, 0x69, 0x64)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt32(z.PPID)
+			if err != nil {
+				err = msgp.WrapError(err, "PPID")
+				return
+			}
+		}
+		if (zb0001Mask & 0x800000) == 0 { // if not omitted
+			// write "status"
+			err = en.Append(0xa6, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Status)
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		}
+		if (zb0001Mask & 0x1000000) == 0 { // if not omitted
+			// write "tgid"
+			err = en.Append(0xa4, 0x74, 0x67, 0x69, 0x64)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt32(z.TGID)
+			if err != nil {
+				err = msgp.WrapError(err, "TGID")
+				return
+			}
+		}
+		// write "times"
+		err = en.Append(0xa5, 0x74, 0x69, 0x6d, 0x65, 0x73)
+		if err != nil {
+			return
+		}
+		err = (*cpuTimesStat)(&z.Times).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Times")
+			return
+		}
+		if (zb0001Mask & 0x4000000) == 0 { // if not omitted
+			// write "uids"
+			err = en.Append(0xa4, 0x75, 0x69, 0x64, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.UIDs)))
+			if err != nil {
+				err = msgp.WrapError(err, "UIDs")
+				return
+			}
+			for za0004 := range z.UIDs {
+				err = en.WriteInt32(z.UIDs[za0004])
+				if err != nil {
+					err = msgp.WrapError(err, "UIDs", za0004)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x8000000) == 0 { // if not omitted
+			// write "username"
+			err = en.Append(0xa8, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Username)
+			if err != nil {
+				err = msgp.WrapError(err, "Username")
+				return
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ProcInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(28)
+	var zb0001Mask uint32 /* 28 bits */
+	_ = zb0001Mask
+	if z.PID == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.IsBackground == false {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.CPUPercent == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.ChildrenPIDs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.CmdLine == "" {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.NumConnections == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if z.CreateTime == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if z.CWD == "" {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if z.ExecPath == "" {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if z.GIDs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if z.IsRunning == false {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
+	if z.MemMaps == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4000
+	}
+	if z.MemPercent == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8000
+	}
+	if z.Name == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10000
+	}
+	if z.Nice == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x20000
+	}
+	if z.NumFDs == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x80000
+	}
+	if z.NumThreads == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x100000
+	}
+	if z.PPID == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400000
+	}
+	if z.Status == "" {
+		zb0001Len--
+		zb0001Mask |= 0x800000
+	}
+	if z.TGID == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x1000000
+	}
+	if z.UIDs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4000000
+	}
+	if z.Username == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8000000
+	}
+	// variable map header, size zb0001Len
+	o = msgp.AppendMapHeader(o, zb0001Len)
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "pid"
+			o = append(o, 0xa3, 0x70, 0x69, 0x64)
+			o = msgp.AppendInt32(o, z.PID)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "is_background"
+			o = append(o, 0xad, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64)
+			o = msgp.AppendBool(o, z.IsBackground)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// string "cpu_percent"
+			o = append(o, 0xab, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74)
+			o = msgp.AppendFloat64(o, z.CPUPercent)
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// string "children_pids"
+			o = append(o, 0xad, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x70, 0x69, 0x64, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.ChildrenPIDs)))
+			for za0001 := range z.ChildrenPIDs {
+				o = msgp.AppendInt32(o, z.ChildrenPIDs[za0001])
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// string "cmd_line"
+			o = append(o, 0xa8, 0x63, 0x6d, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65)
+			o = msgp.AppendString(o, z.CmdLine)
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// string "num_connections"
+			o = append(o, 0xaf, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+			o = msgp.AppendInt(o, z.NumConnections)
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// string "create_time"
+			o = append(o, 0xab, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65)
+			o = msgp.AppendInt64(o, z.CreateTime)
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// string "cwd"
+			o = append(o, 0xa3, 0x63, 0x77, 0x64)
+			o = msgp.AppendString(o, z.CWD)
+		}
+		if (zb0001Mask & 0x200) == 0 { // if not omitted
+			// string "exec_path"
+			o = append(o, 0xa9, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68)
+			o = msgp.AppendString(o, z.ExecPath)
+		}
+		if (zb0001Mask & 0x400) == 0 { // if not omitted
+			// string "gids"
+			o = append(o, 0xa4, 0x67, 0x69, 0x64, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.GIDs)))
+			for za0002 := range z.GIDs {
+				o = msgp.AppendInt32(o, z.GIDs[za0002])
+			}
+		}
+		// string "iocounters"
+		o = append(o, 0xaa, 0x69, 0x6f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73)
+		o, err = (*processIOCountersStat)(&z.IOCounters).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "IOCounters")
+			return
+		}
+		if (zb0001Mask & 0x1000) == 0 { // if not omitted
+			// string "is_running"
+			o = append(o, 0xaa, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67)
+			o = msgp.AppendBool(o, z.IsRunning)
+		}
+		// string "mem_info"
+		o = append(o, 0xa8, 0x6d, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f)
+		o, err = (*processMemoryInfoStat)(&z.MemInfo).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "MemInfo")
+			return
+		}
+		if (zb0001Mask & 0x4000) == 0 { // if not omitted
+			// string "mem_maps"
+			o = append(o, 0xa8, 0x6d, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.MemMaps)))
+			for za0003 := range z.MemMaps {
+				var zb0002 string
+				zb0002, err = processMemoryMapsToMsgp(z.MemMaps[za0003])
+				if err != nil {
+					err = msgp.WrapError(err, "MemMaps", za0003)
+					return
+				}
+				o = msgp.AppendString(o, zb0002)
+			}
+		}
+		if (zb0001Mask & 0x8000) == 0 { // if not omitted
+			// string "mem_percent"
+			o = append(o, 0xab, 0x6d, 0x65, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74)
+			o = msgp.AppendFloat32(o, z.MemPercent)
+		}
+		if (zb0001Mask & 0x10000) == 0 { // if not omitted
+			// string "name"
+			o = append(o, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+			o = msgp.AppendString(o, z.Name)
+		}
+		if (zb0001Mask & 0x20000) == 0 { // if not omitted
+			// string "nice"
+			o = append(o, 0xa4, 0x6e, 0x69, 0x63, 0x65)
+			o = msgp.AppendInt32(o, z.Nice)
+		}
+		// string "num_ctx_switches"
+		o = append(o, 0xb0, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x74, 0x78, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73)
+		o, err = (*processNumCtxSwitchesStat)(&z.NumCtxSwitches).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NumCtxSwitches")
+			return
+		}
+		if (zb0001Mask & 0x80000) == 0 { // if not omitted
+			// string "num_fds"
+			o = append(o, 0xa7, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x64, 0x73)
+			o = msgp.AppendInt32(o, z.NumFDs)
+		}
+		if (zb0001Mask & 0x100000) == 0 { // if not omitted
+			// string "num_threads"
+			o = append(o, 0xab, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73)
+			o = msgp.AppendInt32(o, z.NumThreads)
+		}
+		// string "page_faults"
+		o = append(o, 0xab, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73)
+		o, err = (*processPageFaultsStat)(&z.PageFaults).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "PageFaults")
+			return
+		}
+		if (zb0001Mask & 0x400000) == 0 { // if not omitted
+			// string "ppid"
+			o = append(o, 0xa4, 0x70, 0x70, 0x69, 0x64)
+			o = msgp.AppendInt32(o, z.PPID)
+		}
+		if (zb0001Mask & 0x800000) == 0 { // if not omitted
+			// string "status"
+			o = append(o, 0xa6, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73)
+			o = msgp.AppendString(o, z.Status)
+		}
+		if (zb0001Mask & 0x1000000) == 0 { // if not omitted
+			// string "tgid"
+			o = append(o, 0xa4, 0x74, 0x67, 0x69, 0x64)
+			o = msgp.AppendInt32(o, z.TGID)
+		}
+		// string "times"
+		o = append(o, 0xa5, 0x74, 0x69, 0x6d, 0x65, 0x73)
+		o, err = (*cpuTimesStat)(&z.Times).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Times")
+			return
+		}
+		if (zb0001Mask & 0x4000000) == 0 { // if not omitted
+			// string "uids"
+			o = append(o, 0xa4, 0x75, 0x69, 0x64, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.UIDs)))
+			for za0004 := range z.UIDs {
+				o = msgp.AppendInt32(o, z.UIDs[za0004])
+			}
+		}
+		if (zb0001Mask & 0x8000000) == 0 { // if not omitted
+			// string "username"
+			o = append(o, 0xa8, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65)
+			o = msgp.AppendString(o, z.Username)
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ProcInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "pid":
+			z.PID, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PID")
+				return
+			}
+		case "is_background":
+			z.IsBackground, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "IsBackground")
+				return
+			}
+		case "cpu_percent":
+			z.CPUPercent, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CPUPercent")
+				return
+			}
+		case "children_pids":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ChildrenPIDs")
+				return
+			}
+			if cap(z.ChildrenPIDs) >= int(zb0002) {
+				z.ChildrenPIDs = (z.ChildrenPIDs)[:zb0002]
+			} else {
+				z.ChildrenPIDs = make([]int32, zb0002)
+			}
+			for za0001 := range z.ChildrenPIDs {
+				z.ChildrenPIDs[za0001], bts, err = msgp.ReadInt32Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ChildrenPIDs", za0001)
+					return
+				}
+			}
+		case "cmd_line":
+			z.CmdLine, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CmdLine")
+				return
+			}
+		case "num_connections":
+			z.NumConnections, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumConnections")
+				return
+			}
+		case "create_time":
+			z.CreateTime, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CreateTime")
+				return
+			}
+		case "cwd":
+			z.CWD, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CWD")
+				return
+			}
+		case "exec_path":
+			z.ExecPath, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ExecPath")
+				return
+			}
+		case "gids":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "GIDs")
+				return
+			}
+			if cap(z.GIDs) >= int(zb0003) {
+				z.GIDs = (z.GIDs)[:zb0003]
+			} else {
+				z.GIDs = make([]int32, zb0003)
+			}
+			for za0002 := range z.GIDs {
+				z.GIDs[za0002], bts, err = msgp.ReadInt32Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "GIDs", za0002)
+					return
+				}
+			}
+		case "iocounters":
+			bts, err = (*processIOCountersStat)(&z.IOCounters).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "IOCounters")
+				return
+			}
+		case "is_running":
+			z.IsRunning, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "IsRunning")
+				return
+			}
+		case "mem_info":
+			bts, err = (*processMemoryInfoStat)(&z.MemInfo).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MemInfo")
+				return
+			}
+		case "mem_maps":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MemMaps")
+				return
+			}
+			if cap(z.MemMaps) >= int(zb0004) {
+				z.MemMaps = (z.MemMaps)[:zb0004]
+			} else {
+				z.MemMaps = make([]process.MemoryMapsStat, zb0004)
+			}
+			for za0003 := range z.MemMaps {
+				{
+					var zb0005 string
+					zb0005, bts, err = msgp.ReadStringBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "MemMaps", za0003)
+						return
+					}
+					z.MemMaps[za0003], err = processMemoryMapsFromMsgp(zb0005)
+
+					if err != nil {
+						err = msgp.WrapError(err, "MemMaps", za0003)
+						return
+					}
+				}
+			}
+		case "mem_percent":
+			z.MemPercent, bts, err = msgp.ReadFloat32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MemPercent")
+				return
+			}
+		case "name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "nice":
+			z.Nice, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Nice")
+				return
+			}
+		case "num_ctx_switches":
+			bts, err = (*processNumCtxSwitchesStat)(&z.NumCtxSwitches).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumCtxSwitches")
+				return
+			}
+		case "num_fds":
+			z.NumFDs, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumFDs")
+				return
+			}
+		case "num_threads":
+			z.NumThreads, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumThreads")
+				return
+			}
+		case "page_faults":
+			bts, err = (*processPageFaultsStat)(&z.PageFaults).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PageFaults")
+				return
+			}
+		case "ppid":
+			z.PPID, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PPID")
+				return
+			}
+		case "status":
+			z.Status, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		case "tgid":
+			z.TGID, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TGID")
+				return
+			}
+		case "times":
+			bts, err = (*cpuTimesStat)(&z.Times).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Times")
+				return
+			}
+		case "uids":
+			var zb0006 uint32
+			zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "UIDs")
+				return
+			}
+			if cap(z.UIDs) >= int(zb0006) {
+				z.UIDs = (z.UIDs)[:zb0006]
+			} else {
+				z.UIDs = make([]int32, zb0006)
+			}
+			for za0004 := range z.UIDs {
+				z.UIDs[za0004], bts, err = msgp.ReadInt32Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "UIDs", za0004)
+					return
+				}
+			}
+		case "username":
+			z.Username, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Username")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ProcInfo) Msgsize() (s int) {
+	s = 3 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 4 + msgp.Int32Size + 14 + msgp.BoolSize + 12 + msgp.Float64Size + 14 + msgp.ArrayHeaderSize + (len(z.ChildrenPIDs) * (msgp.Int32Size)) + 9 + msgp.StringPrefixSize + len(z.CmdLine) + 16 + msgp.IntSize + 12 + msgp.Int64Size + 4 + msgp.StringPrefixSize + len(z.CWD) + 10 + msgp.StringPrefixSize + len(z.ExecPath) + 5 + msgp.ArrayHeaderSize + (len(z.GIDs) * (msgp.Int32Size)) + 11 + (*processIOCountersStat)(&z.IOCounters).Msgsize() + 11 + msgp.BoolSize + 9 + (*processMemoryInfoStat)(&z.MemInfo).Msgsize() + 9 + msgp.ArrayHeaderSize
+	for za0003 := range z.MemMaps {
+		var zb0001 string
+		_ = z.MemMaps[za0003]
+		s += msgp.StringPrefixSize + len(zb0001)
+	}
+	s += 12 + msgp.Float32Size + 5 + msgp.StringPrefixSize + len(z.Name) + 5 + msgp.Int32Size + 17 + (*processNumCtxSwitchesStat)(&z.NumCtxSwitches).Msgsize() + 8 + msgp.Int32Size + 12 + msgp.Int32Size + 12 + (*processPageFaultsStat)(&z.PageFaults).Msgsize() + 5 + msgp.Int32Size + 7 + msgp.StringPrefixSize + len(z.Status) + 5 + msgp.Int32Size + 6 + (*cpuTimesStat)(&z.Times).Msgsize() + 5 + msgp.ArrayHeaderSize + (len(z.UIDs) * (msgp.Int32Size)) + 9 + msgp.StringPrefixSize + len(z.Username)
+	return
+}
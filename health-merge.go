@@ -0,0 +1,199 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"net"
+	"slices"
+	"strings"
+)
+
+// MergeHealthInfo merges health reports collected separately, e.g. from
+// the pools of a cluster. The node level entries are concatenated and
+// de-duplicated by node address, preferring entries without an error over
+// entries reporting one. The latest timestamp and its report's version are
+// kept, cluster level info is taken from the first report providing it
+// without error and the distinct errors of all reports are joined. The
+// servers are concatenated too and de-duplicated by endpoint, preferring
+// online servers. The perf collection time is the earliest one known.
+func MergeHealthInfo(infos ...HealthInfoV2) HealthInfoV2 {
+	var merged HealthInfoV2
+	var errs []string
+	var servers []ServerInfo
+	minioSet := false
+	for i, info := range infos {
+		if i == 0 || info.TimeStamp.After(merged.TimeStamp) {
+			merged.TimeStamp = info.TimeStamp
+			merged.Version = info.Version
+		}
+		if info.Error != "" && !slices.Contains(errs, info.Error) {
+			errs = append(errs, info.Error)
+		}
+
+		sys := info.Sys
+		merged.Sys.CPUInfo = append(merged.Sys.CPUInfo, sys.CPUInfo...)
+		merged.Sys.Partitions = append(merged.Sys.Partitions, sys.Partitions...)
+		merged.Sys.OSInfo = append(merged.Sys.OSInfo, sys.OSInfo...)
+		merged.Sys.MemInfo = append(merged.Sys.MemInfo, sys.MemInfo...)
+		merged.Sys.ProcInfo = append(merged.Sys.ProcInfo, sys.ProcInfo...)
+		merged.Sys.NetInfo = append(merged.Sys.NetInfo, sys.NetInfo...)
+		merged.Sys.SysErrs = append(merged.Sys.SysErrs, sys.SysErrs...)
+		merged.Sys.SysServices = append(merged.Sys.SysServices, sys.SysServices...)
+		merged.Sys.SysConfig = append(merged.Sys.SysConfig, sys.SysConfig...)
+		merged.Sys.ProductInfo = append(merged.Sys.ProductInfo, sys.ProductInfo...)
+		if merged.Sys.KubernetesInfo == (KubernetesInfo{}) ||
+			(merged.Sys.KubernetesInfo.Error != "" && sys.KubernetesInfo.Error == "" && sys.KubernetesInfo != (KubernetesInfo{})) {
+			merged.Sys.KubernetesInfo = sys.KubernetesInfo
+		}
+
+		merged.Perf.Drives = append(merged.Perf.Drives, info.Perf.Drives...)
+		if at := info.Perf.CollectedAt; at != nil && (merged.Perf.CollectedAt == nil || at.Before(*merged.Perf.CollectedAt)) {
+			merged.Perf.CollectedAt = info.Perf.CollectedAt
+		}
+		merged.Perf.Net = append(merged.Perf.Net, info.Perf.Net...)
+		if merged.Perf.NetParallel.Addr == "" ||
+			(merged.Perf.NetParallel.Error != "" && info.Perf.NetParallel.Error == "" && info.Perf.NetParallel.Addr != "") {
+			merged.Perf.NetParallel = info.Perf.NetParallel
+		}
+
+		if !minioSet || (merged.Minio.Error != "" && info.Minio.Error == "") {
+			merged.Minio = info.Minio
+			minioSet = true
+		}
+		servers = append(servers, info.Minio.Info.Servers...)
+	}
+	merged.Error = strings.Join(errs, "; ")
+	merged.Minio.Info.Servers = dedupNodes(servers, func(v ServerInfo) NodeCommon {
+		n := NodeCommon{Addr: v.Endpoint}
+		if v.State != "" && v.State != string(ItemOnline) {
+			n.Error = v.State
+		}
+		return n
+	})
+
+	merged.Sys.CPUInfo = dedupNodes(merged.Sys.CPUInfo, func(v CPUs) NodeCommon { return v.NodeCommon })
+	merged.Sys.Partitions = dedupNodes(merged.Sys.Partitions, func(v Partitions) NodeCommon { return v.NodeCommon })
+	merged.Sys.OSInfo = dedupNodes(merged.Sys.OSInfo, func(v OSInfo) NodeCommon { return v.NodeCommon })
+	merged.Sys.MemInfo = dedupNodes(merged.Sys.MemInfo, func(v MemInfo) NodeCommon { return v.NodeCommon })
+	merged.Sys.ProcInfo = dedupNodes(merged.Sys.ProcInfo, func(v ProcInfo) NodeCommon { return v.NodeCommon })
+	merged.Sys.NetInfo = dedupNodes(merged.Sys.NetInfo, func(v NetInfo) NodeCommon { return v.NodeCommon })
+	merged.Sys.SysErrs = dedupNodes(merged.Sys.SysErrs, func(v SysErrors) NodeCommon { return v.NodeCommon })
+	merged.Sys.SysServices = dedupNodes(merged.Sys.SysServices, func(v SysServices) NodeCommon { return v.NodeCommon })
+	merged.Sys.SysConfig = dedupNodes(merged.Sys.SysConfig, func(v SysConfig) NodeCommon { return v.NodeCommon })
+	merged.Sys.ProductInfo = dedupNodes(merged.Sys.ProductInfo, func(v ProductInfo) NodeCommon { return v.NodeCommon })
+	merged.Perf.Drives = dedupNodes(merged.Perf.Drives, func(v DrivePerfInfos) NodeCommon { return v.NodeCommon })
+	merged.Perf.Net = dedupNodes(merged.Perf.Net, func(v NetPerfInfo) NodeCommon { return v.NodeCommon })
+	return merged
+}
+
+// dedupNodes returns items with one entry per node address, keeping the
+// first entry without an error or, if all report one, the first entry.
+func dedupNodes[T any](items []T, node func(T) NodeCommon) []T {
+	var out []T
+	idx := make(map[string]int, len(items))
+	for _, item := range items {
+		n := node(item)
+		if i, ok := idx[n.Addr]; ok {
+			if node(out[i]).Error != "" && n.Error == "" {
+				out[i] = item
+			}
+			continue
+		}
+		idx[n.Addr] = len(out)
+		out = append(out, item)
+	}
+	return out
+}
+
+// FilterNode returns a copy of the health info with only the records of
+// the node addr, across the system info, perf results and servers.
+// Addresses are compared without scheme, case-insensitively and ignoring
+// the port if either address has none. Cluster level info is not
+// included, so the result holds no records if addr isn't found.
+func (info HealthInfoV2) FilterNode(addr string) HealthInfoV2 {
+	match := func(n NodeCommon) bool { return nodeAddrMatches(n.Addr, addr) }
+	sys := info.Sys
+	filtered := HealthInfoV2{
+		Version:   info.Version,
+		Error:     info.Error,
+		TimeStamp: info.TimeStamp,
+		Sys: SysInfo{
+			CPUInfo:     filterNodes(sys.CPUInfo, func(v CPUs) bool { return match(v.NodeCommon) }),
+			Partitions:  filterNodes(sys.Partitions, func(v Partitions) bool { return match(v.NodeCommon) }),
+			OSInfo:      filterNodes(sys.OSInfo, func(v OSInfo) bool { return match(v.NodeCommon) }),
+			MemInfo:     filterNodes(sys.MemInfo, func(v MemInfo) bool { return match(v.NodeCommon) }),
+			ProcInfo:    filterNodes(sys.ProcInfo, func(v ProcInfo) bool { return match(v.NodeCommon) }),
+			NetInfo:     filterNodes(sys.NetInfo, func(v NetInfo) bool { return match(v.NodeCommon) }),
+			SysErrs:     filterNodes(sys.SysErrs, func(v SysErrors) bool { return match(v.NodeCommon) }),
+			SysServices: filterNodes(sys.SysServices, func(v SysServices) bool { return match(v.NodeCommon) }),
+			SysConfig:   filterNodes(sys.SysConfig, func(v SysConfig) bool { return match(v.NodeCommon) }),
+			ProductInfo: filterNodes(sys.ProductInfo, func(v ProductInfo) bool { return match(v.NodeCommon) }),
+		},
+		Perf: PerfInfo{
+			Drives:      filterNodes(info.Perf.Drives, func(v DrivePerfInfos) bool { return match(v.NodeCommon) }),
+			Net:         filterNodes(info.Perf.Net, func(v NetPerfInfo) bool { return match(v.NodeCommon) }),
+			CollectedAt: info.Perf.CollectedAt,
+		},
+	}
+	if match(info.Perf.NetParallel.NodeCommon) {
+		filtered.Perf.NetParallel = info.Perf.NetParallel
+	}
+	filtered.Minio.Info.Servers = filterNodes(info.Minio.Info.Servers, func(v ServerInfo) bool {
+		return nodeAddrMatches(v.Endpoint, addr)
+	})
+	return filtered
+}
+
+// filterNodes returns the items for which keep returns true.
+func filterNodes[T any](items []T, keep func(T) bool) []T {
+	var out []T
+	for _, item := range items {
+		if keep(item) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// nodeAddrMatches returns true if the node addresses a and b refer to
+// the same node, ignoring the scheme and case, and the port if either
+// address has none.
+func nodeAddrMatches(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	hostA, portA := nodeHost(a), nodePort(a)
+	hostB, portB := nodeHost(b), nodePort(b)
+	if !strings.EqualFold(hostA, hostB) {
+		return false
+	}
+	return portA == "" || portB == "" || portA == portB
+}
+
+// nodePort returns the port of a node address, empty if it has none.
+func nodePort(addr string) string {
+	if i := strings.Index(addr, "://"); i >= 0 {
+		addr = addr[i+3:]
+	}
+	if _, port, err := net.SplitHostPort(strings.TrimSuffix(addr, "/")); err == nil {
+		return port
+	}
+	return ""
+}
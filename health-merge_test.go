@@ -0,0 +1,150 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMergeHealthInfo(t *testing.T) {
+	older := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Minute)
+
+	pool1 := HealthInfoV2{
+		Version:   HealthInfoVersion,
+		TimeStamp: older,
+		Sys: SysInfo{
+			CPUInfo: []CPUs{
+				{NodeCommon: NodeCommon{Addr: "node1:9000"}, CPUs: []CPU{{Cores: 4}}},
+				{NodeCommon: NodeCommon{Addr: "node2:9000", Error: "timeout"}},
+			},
+		},
+		Minio: MinioHealthInfo{Error: "minio info unavailable"},
+	}
+	pool2 := HealthInfoV2{
+		Version:   HealthInfoVersion,
+		Error:     "partial",
+		TimeStamp: newer,
+		Sys: SysInfo{
+			CPUInfo: []CPUs{
+				{NodeCommon: NodeCommon{Addr: "node2:9000"}, CPUs: []CPU{{Cores: 8}}},
+				{NodeCommon: NodeCommon{Addr: "node3:9000"}},
+				{NodeCommon: NodeCommon{Addr: "node1:9000", Error: "timeout"}},
+			},
+		},
+		Minio: MinioHealthInfo{Info: MinioInfo{DeploymentID: "deployment"}},
+	}
+
+	merged := MergeHealthInfo(pool1, pool2)
+	if !merged.TimeStamp.Equal(newer) {
+		t.Fatalf("expected latest timestamp %v, got %v", newer, merged.TimeStamp)
+	}
+	if merged.Error != "partial" {
+		t.Fatalf("unexpected error %q", merged.Error)
+	}
+	want := []CPUs{
+		{NodeCommon: NodeCommon{Addr: "node1:9000"}, CPUs: []CPU{{Cores: 4}}},
+		{NodeCommon: NodeCommon{Addr: "node2:9000"}, CPUs: []CPU{{Cores: 8}}},
+		{NodeCommon: NodeCommon{Addr: "node3:9000"}},
+	}
+	if !reflect.DeepEqual(merged.Sys.CPUInfo, want) {
+		t.Fatalf("expected %v, got %v", want, merged.Sys.CPUInfo)
+	}
+	if merged.Minio.Error != "" || merged.Minio.Info.DeploymentID != "deployment" {
+		t.Fatalf("expected minio info without error, got %+v", merged.Minio)
+	}
+	if len(pool1.Sys.CPUInfo) != 2 || pool1.Sys.CPUInfo[1].Error != "timeout" {
+		t.Fatal("input report was modified")
+	}
+}
+
+func TestMergeHealthInfoServers(t *testing.T) {
+	pool1 := HealthInfoV2{Minio: MinioHealthInfo{Info: MinioInfo{
+		DeploymentID: "deployment",
+		Servers: []ServerInfo{
+			{Endpoint: "node1:9000", State: string(ItemOnline)},
+			{Endpoint: "node3:9000", State: string(ItemOffline)},
+		},
+	}}}
+	pool2 := HealthInfoV2{Minio: MinioHealthInfo{Info: MinioInfo{
+		Servers: []ServerInfo{
+			{Endpoint: "node2:9000", State: string(ItemOnline)},
+			{Endpoint: "node3:9000", State: string(ItemOnline), Version: "2024"},
+			{Endpoint: "node1:9000", State: string(ItemOffline)},
+		},
+	}}}
+
+	merged := MergeHealthInfo(pool1, pool2)
+	want := []ServerInfo{
+		{Endpoint: "node1:9000", State: string(ItemOnline)},
+		{Endpoint: "node3:9000", State: string(ItemOnline), Version: "2024"},
+		{Endpoint: "node2:9000", State: string(ItemOnline)},
+	}
+	if !reflect.DeepEqual(merged.Minio.Info.Servers, want) {
+		t.Fatalf("expected %v, got %v", want, merged.Minio.Info.Servers)
+	}
+	if merged.Minio.Info.DeploymentID != "deployment" {
+		t.Fatalf("expected cluster info of the first report, got %+v", merged.Minio.Info)
+	}
+	if len(pool1.Minio.Info.Servers) != 2 || pool1.Minio.Info.Servers[1].State != string(ItemOffline) {
+		t.Fatal("input report was modified")
+	}
+}
+
+func TestHealthInfoV2FilterNode(t *testing.T) {
+	info := HealthInfoV2{
+		Version: HealthInfoVersion2,
+		Sys: SysInfo{
+			CPUInfo: []CPUs{{NodeCommon: NodeCommon{Addr: "node1:9000"}}, {NodeCommon: NodeCommon{Addr: "node2:9000"}}},
+			MemInfo: []MemInfo{{NodeCommon: NodeCommon{Addr: "node2:9000"}}},
+		},
+		Perf: PerfInfo{
+			Drives:      []DrivePerfInfos{{NodeCommon: NodeCommon{Addr: "node1:9000"}}},
+			NetParallel: NetPerfInfo{NodeCommon: NodeCommon{Addr: "node1:9000"}},
+		},
+		Minio: MinioHealthInfo{Info: MinioInfo{
+			DeploymentID: "deployment",
+			Servers:      []ServerInfo{{Endpoint: "http://node1:9000"}, {Endpoint: "http://node2:9000"}},
+		}},
+	}
+
+	for _, addr := range []string{"node1:9000", "NODE1", "https://node1:9000"} {
+		f := info.FilterNode(addr)
+		if f.Version != HealthInfoVersion2 || len(f.Sys.CPUInfo) != 1 || f.Sys.CPUInfo[0].Addr != "node1:9000" ||
+			len(f.Sys.MemInfo) != 0 || len(f.Perf.Drives) != 1 || f.Perf.NetParallel.Addr != "node1:9000" ||
+			len(f.Minio.Info.Servers) != 1 || f.Minio.Info.Servers[0].Endpoint != "http://node1:9000" {
+			t.Fatalf("%s: unexpected filtered report %+v", addr, f)
+		}
+		if f.Minio.Info.DeploymentID != "" {
+			t.Fatalf("%s: expected cluster level info to be dropped", addr)
+		}
+	}
+
+	f := info.FilterNode("node1:9001")
+	if len(f.Sys.CPUInfo) != 0 || len(f.Perf.Drives) != 0 || f.Perf.NetParallel.Addr != "" || len(f.Minio.Info.Servers) != 0 {
+		t.Fatalf("expected no records for another port, got %+v", f)
+	}
+	if _, err := json.Marshal(f); err != nil {
+		t.Fatal(err)
+	}
+}
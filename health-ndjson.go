@@ -0,0 +1,95 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// WriteNDJSON writes the health info as newline delimited JSON, one object
+// per line. The first line holds the report version, timestamp and error,
+// followed by one line per node level record. Each object has a "_type"
+// field naming the record: report, cpu, disk, os, mem, proc, net,
+// sys_errors, sys_services, sys_config, product, kubernetes, drive_perf,
+// net_perf, net_parallel_perf and server.
+func (info HealthInfoV2) WriteNDJSON(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	write := func(typ string, v interface{}) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		bw.WriteString(`{"_type":"` + typ + `"`)
+		if len(data) > 2 {
+			bw.WriteByte(',')
+		}
+		bw.Write(data[1:])
+		return bw.WriteByte('\n')
+	}
+	present := func(ok bool) int {
+		if ok {
+			return 1
+		}
+		return 0
+	}
+
+	report := struct {
+		Version   string    `json:"version"`
+		Error     string    `json:"error,omitempty"`
+		TimeStamp time.Time `json:"timestamp,omitempty"`
+	}{Version: info.Version, Error: info.Error, TimeStamp: info.TimeStamp}
+	if err := write("report", report); err != nil {
+		return err
+	}
+
+	sys, perf := info.Sys, info.Perf
+	records := []struct {
+		typ  string
+		n    int
+		item func(i int) interface{}
+	}{
+		{"cpu", len(sys.CPUInfo), func(i int) interface{} { return sys.CPUInfo[i] }},
+		{"disk", len(sys.Partitions), func(i int) interface{} { return sys.Partitions[i] }},
+		{"os", len(sys.OSInfo), func(i int) interface{} { return sys.OSInfo[i] }},
+		{"mem", len(sys.MemInfo), func(i int) interface{} { return sys.MemInfo[i] }},
+		{"proc", len(sys.ProcInfo), func(i int) interface{} { return sys.ProcInfo[i] }},
+		{"net", len(sys.NetInfo), func(i int) interface{} { return sys.NetInfo[i] }},
+		{"sys_errors", len(sys.SysErrs), func(i int) interface{} { return sys.SysErrs[i] }},
+		{"sys_services", len(sys.SysServices), func(i int) interface{} { return sys.SysServices[i] }},
+		{"sys_config", len(sys.SysConfig), func(i int) interface{} { return sys.SysConfig[i] }},
+		{"product", len(sys.ProductInfo), func(i int) interface{} { return sys.ProductInfo[i] }},
+		{"kubernetes", present(sys.KubernetesInfo != KubernetesInfo{}), func(int) interface{} { return sys.KubernetesInfo }},
+		{"drive_perf", len(perf.Drives), func(i int) interface{} { return perf.Drives[i] }},
+		{"net_perf", len(perf.Net), func(i int) interface{} { return perf.Net[i] }},
+		{"net_parallel_perf", present(perf.NetParallel.Addr != ""), func(int) interface{} { return perf.NetParallel }},
+		{"server", len(info.Minio.Info.Servers), func(i int) interface{} { return info.Minio.Info.Servers[i] }},
+	}
+	for _, r := range records {
+		for i := 0; i < r.n; i++ {
+			if err := write(r.typ, r.item(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHealthInfoV2WriteNDJSON(t *testing.T) {
+	info := HealthInfoV2{
+		Version:   HealthInfoVersion2,
+		TimeStamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Sys: SysInfo{
+			CPUInfo: []CPUs{{NodeCommon: NodeCommon{Addr: "node1:9000"}}, {NodeCommon: NodeCommon{Addr: "node2:9000"}}},
+			MemInfo: []MemInfo{{NodeCommon: NodeCommon{Addr: "node1:9000"}, Total: 100}},
+		},
+		Perf: PerfInfo{
+			NetParallel: NetPerfInfo{NodeCommon: NodeCommon{Addr: "node1:9000"}},
+		},
+		Minio: MinioHealthInfo{Info: MinioInfo{Servers: []ServerInfo{{Endpoint: "node1:9000"}}}},
+	}
+	var buf bytes.Buffer
+	if err := info.WriteNDJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var types, addrs []string
+	sc := bufio.NewScanner(&buf)
+	for sc.Scan() {
+		var rec struct {
+			Type     string `json:"_type"`
+			Addr     string `json:"addr"`
+			Endpoint string `json:"endpoint"`
+		}
+		if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			t.Fatalf("invalid line %q: %v", sc.Text(), err)
+		}
+		types = append(types, rec.Type)
+		addrs = append(addrs, rec.Addr+rec.Endpoint)
+	}
+	wantTypes := []string{"report", "cpu", "cpu", "mem", "net_parallel_perf", "server"}
+	if !reflect.DeepEqual(types, wantTypes) {
+		t.Fatalf("expected records %v, got %v", wantTypes, types)
+	}
+	wantAddrs := []string{"", "node1:9000", "node2:9000", "node1:9000", "node1:9000", "node1:9000"}
+	if !reflect.DeepEqual(addrs, wantAddrs) {
+		t.Fatalf("expected addresses %v, got %v", wantAddrs, addrs)
+	}
+}
@@ -20,10 +20,26 @@
 package madmin
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
 	"math/big"
+	"net"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/shirou/gopsutil/v3/cpu"
 	diskhw "github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/host"
@@ -31,6 +47,29 @@ import (
 	"github.com/shirou/gopsutil/v3/process"
 )
 
+//msgp:clearomitted
+//msgp:tag json
+//go:generate msgp -file $GOFILE
+
+//msgp:ignore HealthReport HealthInfoV0 RedactOptions SysHealthInfo ServerProcInfo ProcessNode SysProcess ProcessState
+//msgp:ignore ServerMemInfo MemPressure ServerOsInfo ServerCPUInfo MinioHealthInfoV0 ServerDiskHwInfo
+//msgp:ignore SmartInfo SmartNvmeInfo SmartScsiInfo SmartAtaInfo PartitionStat DriveRef driveRisk partitionRisk DriveHealth
+//msgp:ignore PerfInfoV0 ServerDrivesInfo DiskLatency DiskThroughput DrivePerfInfoV0
+//msgp:ignore ServerNetHealthInfo NetLatency NetThroughput NetPerfInfoV0
+//msgp:replace NodeCommon with:nodeCommon
+
+// HealthReport - accessors shared by the health info versions
+type HealthReport interface {
+	JSON() string
+	GetError() string
+	GetTimestamp() time.Time
+}
+
+var (
+	_ HealthReport = HealthInfoV0{}
+	_ HealthReport = HealthInfoV2{}
+)
+
 // HealthInfoV0 - MinIO cluster's health Info version 0
 type HealthInfoV0 struct {
 	TimeStamp time.Time         `json:"timestamp,omitempty"`
@@ -86,6 +125,175 @@ func (info HealthInfoV2) GetTimestamp() time.Time {
 	return info.TimeStamp
 }
 
+// TimestampIn - returns the timestamp of the cluster health info v2
+// in the given location, UTC if loc is nil
+func (info HealthInfoV2) TimestampIn(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return info.TimeStamp.In(loc)
+}
+
+// Summary returns a single line summary of the report, e.g.
+// "3 nodes, 36 drives (2 warning), 120 TiB used / 400 TiB, MinIO process CPU avg 42%".
+// Drives with an error or low on free space count as warning, the CPU
+// usage is the average of the MinIO processes of the nodes, not the CPU
+// usage of the nodes. Values which are not available in the report are
+// shown as "n/a".
+func (info HealthInfoV2) Summary() string {
+	var drives, warnings int
+	var total, free uint64
+	for _, parts := range info.Sys.Partitions {
+		for _, p := range parts.Partitions {
+			drives++
+			if p.Error != "" {
+				warnings++
+				continue
+			}
+			total += p.SpaceTotal
+			free += p.SpaceFree
+			if p.SpaceTotal > 0 {
+				if _, low := freeSeverity(p.SpaceFree, p.SpaceTotal); low {
+					warnings++
+				}
+			}
+		}
+	}
+	var cpu float64
+	var procs int
+	for _, p := range info.Sys.ProcInfo {
+		if p.Error == "" {
+			cpu += p.CPUPercent
+			procs++
+		}
+	}
+
+	line := strconv.Itoa(len(info.nodeAddrs())) + " nodes, "
+	if drives > 0 {
+		line += strconv.Itoa(drives) + " drives"
+		if warnings > 0 {
+			line += " (" + strconv.Itoa(warnings) + " warning)"
+		}
+	} else {
+		line += "n/a drives"
+	}
+	if total > 0 {
+		line += ", " + humanize.IBytes(total-free) + " used / " + humanize.IBytes(total)
+	} else {
+		line += ", n/a used"
+	}
+	if procs > 0 {
+		line += ", MinIO process CPU avg " + strconv.FormatFloat(cpu/float64(procs), 'f', 0, 64) + "%"
+	} else {
+		line += ", MinIO process CPU avg n/a"
+	}
+	return line
+}
+
+// RedactOptions selects the fields removed by HealthInfoV2.Redact.
+type RedactOptions struct {
+	DropMemMaps bool // Drop the memory maps of processes.
+	DropEnv     bool // Drop the MinIO environment variables of servers.
+	DropCmdLine bool // Drop the command lines of processes.
+}
+
+// Redact returns a copy of the health info without the fields selected
+// by opts, suitable for sharing externally. info is not modified.
+func (info HealthInfoV2) Redact(opts RedactOptions) HealthInfoV2 {
+	if opts.DropMemMaps || opts.DropCmdLine {
+		procs := make([]ProcInfo, len(info.Sys.ProcInfo))
+		for i, p := range info.Sys.ProcInfo {
+			if opts.DropMemMaps {
+				p.MemMaps = nil
+			}
+			if opts.DropCmdLine {
+				p.CmdLine = ""
+			}
+			procs[i] = p
+		}
+		info.Sys.ProcInfo = procs
+	}
+	if opts.DropEnv {
+		servers := make([]ServerInfo, len(info.Minio.Info.Servers))
+		for i, srv := range info.Minio.Info.Servers {
+			srv.MinioEnvVars = nil
+			servers[i] = srv
+		}
+		info.Minio.Info.Servers = servers
+	}
+	return info
+}
+
+// MarshalBinary encodes the health info as msgpack,
+// see the generated MarshalMsg.
+func (info HealthInfoV2) MarshalBinary() ([]byte, error) {
+	return info.MarshalMsg(nil)
+}
+
+// UnmarshalBinary decodes health info encoded by MarshalBinary.
+func (info *HealthInfoV2) UnmarshalBinary(data []byte) error {
+	_, err := info.UnmarshalMsg(data)
+	return err
+}
+
+// DecodeHealthInfo decodes a health info stream as sent by the server,
+// which starts with the version object followed by one or more health
+// info objects, each superseding the previous one. If the stream is cut
+// off, the sections decoded so far are returned along with the error.
+func DecodeHealthInfo(r io.Reader) (HealthInfoV2, error) {
+	var info HealthInfoV2
+	dec := json.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return info, nil
+		}
+		if err != nil {
+			return info, err
+		}
+		if tok != json.Delim('{') {
+			return info, fmt.Errorf("unexpected health info token %v", tok)
+		}
+		for dec.More() {
+			tok, err = dec.Token()
+			if err != nil {
+				return info, err
+			}
+			switch tok {
+			case "version":
+				err = dec.Decode(&info.Version)
+			case "error":
+				err = dec.Decode(&info.Error)
+			case "timestamp":
+				err = dec.Decode(&info.TimeStamp)
+			case "sys":
+				var sys SysInfo
+				if err = dec.Decode(&sys); err == nil {
+					info.Sys = sys
+				}
+			case "perf":
+				var perf PerfInfo
+				if err = dec.Decode(&perf); err == nil {
+					info.Perf = perf
+				}
+			case "minio":
+				var minio MinioHealthInfo
+				if err = dec.Decode(&minio); err == nil {
+					info.Minio = minio
+				}
+			default:
+				err = dec.Decode(&json.RawMessage{})
+			}
+			if err != nil {
+				return info, err
+			}
+		}
+		if _, err = dec.Token(); err != nil {
+			return info, err
+		}
+	}
+}
+
 // Latency contains write operation latency in seconds of a disk drive.
 type Latency struct {
 	Avg          float64 `json:"avg"`
@@ -106,6 +314,64 @@ type Throughput struct {
 	Percentile99 uint64 `json:"percentile_99"`
 }
 
+// String returns the avg, p50, p90 and p99 throughput in binary units,
+// e.g. "avg=1.20 GiB/s p50=1.15 GiB/s p90=1.30 GiB/s p99=1.42 GiB/s".
+func (t Throughput) String() string {
+	return "avg=" + formatBytesPerSec(t.Avg) +
+		" p50=" + formatBytesPerSec(t.Percentile50) +
+		" p90=" + formatBytesPerSec(t.Percentile90) +
+		" p99=" + formatBytesPerSec(t.Percentile99)
+}
+
+// formatBytesPerSec formats a bytes per second rate
+// using binary units and two decimals.
+func formatBytesPerSec(v uint64) string {
+	const units = "KMGTPE"
+	if v < humanize.KiByte {
+		return strconv.FormatUint(v, 10) + " B/s"
+	}
+	f, i := float64(v)/humanize.KiByte, 0
+	for f >= humanize.KiByte && i < len(units)-1 {
+		f /= humanize.KiByte
+		i++
+	}
+	return strconv.FormatFloat(f, 'f', 2, 64) + " " + units[i:i+1] + "iB/s"
+}
+
+// Percentile returns the latency at percentile p (0-100), linearly
+// interpolated between the known min, p50, p90 and p99 values.
+// Max is returned for p above 99 and Min for p at or below 0.
+func (l Latency) Percentile(p float64) float64 {
+	return interpolatePercentile(p, l.Min, l.Percentile50, l.Percentile90, l.Percentile99, l.Max)
+}
+
+// Percentile returns the throughput at percentile p (0-100), linearly
+// interpolated between the known min, p50, p90 and p99 values.
+// Max is returned for p above 99 and Min for p at or below 0.
+func (t Throughput) Percentile(p float64) uint64 {
+	v := interpolatePercentile(p, float64(t.Min), float64(t.Percentile50),
+		float64(t.Percentile90), float64(t.Percentile99), float64(t.Max))
+	return uint64(math.Round(v))
+}
+
+func interpolatePercentile(p, minV, p50, p90, p99, maxV float64) float64 {
+	lerp := func(p, p0, p1, v0, v1 float64) float64 {
+		return v0 + (v1-v0)*(p-p0)/(p1-p0)
+	}
+	switch {
+	case p <= 0:
+		return minV
+	case p < 50:
+		return lerp(p, 0, 50, minV, p50)
+	case p < 90:
+		return lerp(p, 50, 90, p50, p90)
+	case p <= 99:
+		return lerp(p, 90, 99, p90, p99)
+	default:
+		return maxV
+	}
+}
+
 // DrivePerfInfo contains disk drive's performance information.
 type DrivePerfInfo struct {
 	Error string `json:"error,omitempty"`
@@ -123,6 +389,34 @@ type DrivePerfInfos struct {
 	ParallelPerf []DrivePerfInfo `json:"parallel_perf,omitempty"`
 }
 
+// SlowestDrive returns the drive with the highest 99th percentile latency
+// across the serial and parallel perf results, ignoring drives reporting
+// an error. ok is false when there are no healthy drives.
+func (d DrivePerfInfos) SlowestDrive() (slowest DrivePerfInfo, ok bool) {
+	for _, perfs := range [][]DrivePerfInfo{d.SerialPerf, d.ParallelPerf} {
+		for _, perf := range perfs {
+			if perf.Error != "" {
+				continue
+			}
+			if !ok || perf.Latency.Percentile99 > slowest.Latency.Percentile99 {
+				slowest, ok = perf, true
+			}
+		}
+	}
+	return slowest, ok
+}
+
+// SortDrivePerfInfos sorts the drive perf results by path.
+func SortDrivePerfInfos(s []DrivePerfInfo) {
+	sort.SliceStable(s, func(i, j int) bool { return s[i].Path < s[j].Path })
+}
+
+// SortDrivePerfNodes sorts the drive perf results of the nodes by node
+// address. The per drive results of the nodes are left untouched.
+func SortDrivePerfNodes(s []DrivePerfInfos) {
+	sort.SliceStable(s, func(i, j int) bool { return s[i].Addr < s[j].Addr })
+}
+
 // PeerNetPerfInfo contains network performance information of a node.
 type PeerNetPerfInfo struct {
 	NodeCommon
@@ -131,6 +425,19 @@ type PeerNetPerfInfo struct {
 	Throughput Throughput `json:"throughput,omitempty"`
 }
 
+// Asymmetry returns the ratio of the average throughput to the peer and
+// the average throughput of the reverse direction, as measured by the
+// peer. A ratio far from 1 hints at half-duplex or misconfigured links.
+// 1 and false are returned if reverse is nil or either direction has no
+// valid measurement, see PerfInfo.ReversePeer to find the reverse.
+func (p PeerNetPerfInfo) Asymmetry(reverse *PeerNetPerfInfo) (float64, bool) {
+	if reverse == nil || p.Error != "" || reverse.Error != "" ||
+		p.Throughput.Avg == 0 || reverse.Throughput.Avg == 0 {
+		return 1, false
+	}
+	return float64(p.Throughput.Avg) / float64(reverse.Throughput.Avg), true
+}
+
 // NetPerfInfo contains network performance information of a node to other nodes.
 type NetPerfInfo struct {
 	NodeCommon
@@ -138,11 +445,274 @@ type NetPerfInfo struct {
 	RemotePeers []PeerNetPerfInfo `json:"remote_peers,omitempty"`
 }
 
+// Self returns the entry of RemotePeers measuring the node to itself,
+// or nil if there is none. Peers are matched by address first and by
+// host and port if no address matches, ignoring the port only when one
+// of the addresses has none.
+func (n NetPerfInfo) Self() *PeerNetPerfInfo {
+	for i := range n.RemotePeers {
+		if n.RemotePeers[i].Addr == n.Addr {
+			return &n.RemotePeers[i]
+		}
+	}
+	for i := range n.RemotePeers {
+		if nodeAddrMatches(n.RemotePeers[i].Addr, n.Addr) {
+			return &n.RemotePeers[i]
+		}
+	}
+	return nil
+}
+
+// ExternalPeers returns RemotePeers without the measurement of the node
+// to itself, as matched by Self.
+func (n NetPerfInfo) ExternalPeers() []PeerNetPerfInfo {
+	self := n.Self()
+	peers := make([]PeerNetPerfInfo, 0, len(n.RemotePeers))
+	for i := range n.RemotePeers {
+		if &n.RemotePeers[i] != self {
+			peers = append(peers, n.RemotePeers[i])
+		}
+	}
+	return peers
+}
+
+// nodeHost returns the host of a node address, without scheme and port.
+func nodeHost(addr string) string {
+	if i := strings.Index(addr, "://"); i >= 0 {
+		addr = addr[i+3:]
+	}
+	addr = strings.TrimSuffix(addr, "/")
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+}
+
 // PerfInfo - Includes Drive and Net perf info for the entire MinIO cluster
 type PerfInfo struct {
 	Drives      []DrivePerfInfos `json:"drives,omitempty"`
 	Net         []NetPerfInfo    `json:"net,omitempty"`
 	NetParallel NetPerfInfo      `json:"net_parallel,omitempty"`
+
+	// CollectedAt is the time the perf results were collected,
+	// nil if not known, e.g. for reports saved by older clients.
+	CollectedAt *time.Time `json:"collected_at,omitempty"`
+}
+
+// Encode writes the perf results to w as compact JSON followed by a
+// newline. Unlike indenting the output it needs no second copy of the
+// encoded data, which matters for the results of large clusters.
+func (p PerfInfo) Encode(w io.Writer) error {
+	return json.NewEncoder(w).Encode(p)
+}
+
+// Age returns the time elapsed since the perf results were collected,
+// 0 if the collection time is not known.
+func (p PerfInfo) Age() time.Duration {
+	if p.CollectedAt == nil || p.CollectedAt.IsZero() {
+		return 0
+	}
+	return time.Since(*p.CollectedAt)
+}
+
+// WriteDriveCSV writes the drive perf results as CSV, one row per drive
+// and mode with a header row. Drives that failed keep their row with the
+// error in the last column, nodes that failed get a row without a path.
+func (p PerfInfo) WriteDriveCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{
+		"addr", "mode", "path",
+		"latency_p50", "latency_p90", "latency_p99",
+		"throughput_avg", "throughput_p50", "throughput_p90", "throughput_p99",
+		"error",
+	})
+	f := func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+	u := func(v uint64) string { return strconv.FormatUint(v, 10) }
+	for _, node := range p.Drives {
+		if node.Error != "" && len(node.SerialPerf) == 0 && len(node.ParallelPerf) == 0 {
+			cw.Write([]string{node.Addr, "", "", "", "", "", "", "", "", "", node.Error})
+			continue
+		}
+		for _, mode := range []struct {
+			name  string
+			perfs []DrivePerfInfo
+		}{{"serial", node.SerialPerf}, {"parallel", node.ParallelPerf}} {
+			for _, d := range mode.perfs {
+				cw.Write([]string{
+					node.Addr, mode.name, d.Path,
+					f(d.Latency.Percentile50), f(d.Latency.Percentile90), f(d.Latency.Percentile99),
+					u(d.Throughput.Avg), u(d.Throughput.Percentile50), u(d.Throughput.Percentile90), u(d.Throughput.Percentile99),
+					d.Error,
+				})
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// TotalNetThroughput returns the sum of the average throughput in bytes
+// per second measured to each peer by the parallel network test, where all
+// nodes send at the same time, i.e. the aggregate network throughput of the
+// cluster. The serial per node measurements are not used. Peers reporting
+// an error are skipped.
+func (p PerfInfo) TotalNetThroughput() uint64 {
+	var total uint64
+	for _, peer := range p.NetParallel.RemotePeers {
+		if peer.Error == "" {
+			total += peer.Throughput.Avg
+		}
+	}
+	return total
+}
+
+// LatencyBounds returns the lowest and highest 99th percentile drive
+// latency in seconds across all nodes, ignoring drives and nodes reporting
+// an error. ok is false when there are no healthy drives.
+func (p PerfInfo) LatencyBounds() (lo, hi float64, ok bool) {
+	for _, node := range p.Drives {
+		if node.Error != "" {
+			continue
+		}
+		for _, perfs := range [][]DrivePerfInfo{node.SerialPerf, node.ParallelPerf} {
+			for _, perf := range perfs {
+				if perf.Error != "" {
+					continue
+				}
+				l := perf.Latency.Percentile99
+				if !ok {
+					lo, hi, ok = l, l, true
+					continue
+				}
+				if l < lo {
+					lo = l
+				}
+				if l > hi {
+					hi = l
+				}
+			}
+		}
+	}
+	return lo, hi, ok
+}
+
+// ApproxEqual returns true if p and other contain the same nodes, drives
+// and peers in the same order, with latencies and throughputs differing by
+// at most epsilon relative to the larger value, e.g. 0.01 for 1%. All other
+// fields, including errors, must match exactly, except CollectedAt which is
+// ignored.
+func (p PerfInfo) ApproxEqual(other PerfInfo, epsilon float64) bool {
+	if len(p.Drives) != len(other.Drives) || len(p.Net) != len(other.Net) {
+		return false
+	}
+	for i, d := range p.Drives {
+		o := other.Drives[i]
+		if d.NodeCommon != o.NodeCommon ||
+			!drivePerfsApproxEqual(d.SerialPerf, o.SerialPerf, epsilon) ||
+			!drivePerfsApproxEqual(d.ParallelPerf, o.ParallelPerf, epsilon) {
+			return false
+		}
+	}
+	for i, n := range p.Net {
+		if !n.approxEqual(other.Net[i], epsilon) {
+			return false
+		}
+	}
+	return p.NetParallel.approxEqual(other.NetParallel, epsilon)
+}
+
+func drivePerfsApproxEqual(a, b []DrivePerfInfo, epsilon float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Error != b[i].Error || a[i].Path != b[i].Path ||
+			!a[i].Latency.approxEqual(b[i].Latency, epsilon) ||
+			!a[i].Throughput.approxEqual(b[i].Throughput, epsilon) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n NetPerfInfo) approxEqual(o NetPerfInfo, epsilon float64) bool {
+	if n.NodeCommon != o.NodeCommon || len(n.RemotePeers) != len(o.RemotePeers) {
+		return false
+	}
+	for i, peer := range n.RemotePeers {
+		op := o.RemotePeers[i]
+		if peer.NodeCommon != op.NodeCommon ||
+			!peer.Latency.approxEqual(op.Latency, epsilon) ||
+			!peer.Throughput.approxEqual(op.Throughput, epsilon) {
+			return false
+		}
+	}
+	return true
+}
+
+func (l Latency) approxEqual(o Latency, epsilon float64) bool {
+	return floatsApproxEqual(l.Avg, o.Avg, epsilon) &&
+		floatsApproxEqual(l.Max, o.Max, epsilon) &&
+		floatsApproxEqual(l.Min, o.Min, epsilon) &&
+		floatsApproxEqual(l.Percentile50, o.Percentile50, epsilon) &&
+		floatsApproxEqual(l.Percentile90, o.Percentile90, epsilon) &&
+		floatsApproxEqual(l.Percentile99, o.Percentile99, epsilon)
+}
+
+func (t Throughput) approxEqual(o Throughput, epsilon float64) bool {
+	f := func(a, b uint64) bool { return floatsApproxEqual(float64(a), float64(b), epsilon) }
+	return f(t.Avg, o.Avg) && f(t.Max, o.Max) && f(t.Min, o.Min) &&
+		f(t.Percentile50, o.Percentile50) &&
+		f(t.Percentile90, o.Percentile90) &&
+		f(t.Percentile99, o.Percentile99)
+}
+
+// floatsApproxEqual returns true if a and b differ by at most
+// epsilon relative to the larger of their absolute values.
+func floatsApproxEqual(a, b, epsilon float64) bool {
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b) <= epsilon*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// ReversePeer returns the measurement of the node peerAddr to the node
+// addr, i.e. the reverse of the measurement of addr to peerAddr, or nil
+// if peerAddr didn't measure addr.
+func (p PerfInfo) ReversePeer(addr, peerAddr string) *PeerNetPerfInfo {
+	for i := range p.Net {
+		if p.Net[i].Addr != peerAddr {
+			continue
+		}
+		for j := range p.Net[i].RemotePeers {
+			if p.Net[i].RemotePeers[j].Addr == addr {
+				return &p.Net[i].RemotePeers[j]
+			}
+		}
+	}
+	return nil
+}
+
+// BuildLatencyMatrix returns the p99 latency in seconds measured from each
+// source node to each destination peer, indexed by source and destination
+// address. Pairs without a successful measurement are absent.
+func BuildLatencyMatrix(nets []NetPerfInfo) map[string]map[string]float64 {
+	matrix := make(map[string]map[string]float64, len(nets))
+	for _, n := range nets {
+		if n.Error != "" {
+			continue
+		}
+		for _, peer := range n.RemotePeers {
+			if peer.Error != "" {
+				continue
+			}
+			if matrix[n.Addr] == nil {
+				matrix[n.Addr] = make(map[string]float64, len(n.RemotePeers))
+			}
+			matrix[n.Addr][peer.Addr] = peer.Latency.Percentile99
+		}
+	}
+	return matrix
 }
 
 func (info HealthInfoV0) String() string {
@@ -162,6 +732,24 @@ func (info HealthInfoV0) JSON() string {
 	return string(data)
 }
 
+// GetError - returns error from the cluster health info v0
+func (info HealthInfoV0) GetError() string {
+	return info.Error
+}
+
+// GetStatus - returns status of the cluster health info v0
+func (info HealthInfoV0) GetStatus() string {
+	if info.Error != "" {
+		return "error"
+	}
+	return "success"
+}
+
+// GetTimestamp - returns timestamp from the cluster health info v0
+func (info HealthInfoV0) GetTimestamp() time.Time {
+	return info.TimeStamp
+}
+
 // SysHealthInfo - Includes hardware and system information of the MinIO cluster
 type SysHealthInfo struct {
 	CPUInfo    []ServerCPUInfo    `json:"cpus,omitempty"`
@@ -172,6 +760,34 @@ type SysHealthInfo struct {
 	Error      string             `json:"error,omitempty"`
 }
 
+// NodeErrors returns the errors reported by each node across the CPU,
+// drive, OS, memory and process info, keyed by node address.
+// Nodes without errors are not included.
+func (s SysHealthInfo) NodeErrors() map[string][]string {
+	errs := make(map[string][]string)
+	add := func(addr, err string) {
+		if err != "" {
+			errs[addr] = append(errs[addr], err)
+		}
+	}
+	for _, c := range s.CPUInfo {
+		add(c.Addr, c.Error)
+	}
+	for _, d := range s.DiskHwInfo {
+		add(d.Addr, d.Error)
+	}
+	for _, o := range s.OsInfo {
+		add(o.Addr, o.Error)
+	}
+	for _, m := range s.MemInfo {
+		add(m.Addr, m.Error)
+	}
+	for _, p := range s.ProcInfo {
+		add(p.Addr, p.Error)
+	}
+	return errs
+}
+
 // ServerProcInfo - Includes host process lvl information
 type ServerProcInfo struct {
 	Addr      string       `json:"addr"`
@@ -179,6 +795,157 @@ type ServerProcInfo struct {
 	Error     string       `json:"error,omitempty"`
 }
 
+// NewAnonymizeSalt returns a random salt for ServerProcInfo.AnonymizeWithSalt.
+// A new salt should be generated for every report.
+func NewAnonymizeSalt() ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// anonymizeSalt is the salt used by ServerProcInfo.Anonymize, generated
+// once per process.
+var anonymizeSalt = sync.OnceValue(func() []byte {
+	salt, err := NewAnonymizeSalt()
+	if err != nil {
+		panic(fmt.Sprintf("madmin: unable to generate anonymize salt: %v", err))
+	}
+	return salt
+})
+
+// Anonymize returns a copy of the process info with usernames replaced by
+// stable hashes, and command lines, user and group ids removed, see
+// AnonymizeWithSalt. The salt is generated once per process rather than
+// per report: usernames are correlated across all the reports anonymized
+// by the same process, use AnonymizeWithSalt to prevent that.
+func (s ServerProcInfo) Anonymize() ServerProcInfo {
+	return s.AnonymizeWithSalt(anonymizeSalt())
+}
+
+// AnonymizeWithSalt returns a copy of the process info with usernames
+// replaced by their HMAC-SHA256 keyed with salt, truncated to 48 bits, and
+// command lines, user and group ids removed. Using the same salt for all
+// nodes of a report keeps processes of the same user correlated within
+// that report, while the salt, when generated per report with
+// NewAnonymizeSalt, keeps usernames from being recovered by hashing
+// candidate names or linked across reports. The truncation keeps names
+// short; collisions are unlikely for the number of users of a report but
+// two users may in rare cases share a name.
+func (s ServerProcInfo) AnonymizeWithSalt(salt []byte) ServerProcInfo {
+	procs := make([]SysProcess, len(s.Processes))
+	for i, p := range s.Processes {
+		if p.Username != "" {
+			mac := hmac.New(sha256.New, salt)
+			mac.Write([]byte(p.Username))
+			p.Username = "user-" + hex.EncodeToString(mac.Sum(nil)[:6])
+		}
+		p.CmdLine = ""
+		p.Uids = nil
+		p.Gids = nil
+		procs[i] = p
+	}
+	s.Processes = procs
+	return s
+}
+
+// TotalConnections returns the sum of the connection counts of all processes.
+func (s ServerProcInfo) TotalConnections() int {
+	total := 0
+	for _, p := range s.Processes {
+		total += p.ConnectionCount
+	}
+	return total
+}
+
+// HighFDProcesses returns the processes with more than threshold open
+// file descriptors. Processes not reporting their file descriptors are
+// not included.
+func (s ServerProcInfo) HighFDProcesses(threshold int32) []SysProcess {
+	var procs []SysProcess
+	for _, p := range s.Processes {
+		if p.NumFds > 0 && p.NumFds > threshold {
+			procs = append(procs, p)
+		}
+	}
+	return procs
+}
+
+// TotalConnections returns the total connection count of the processes
+// of each node. Nodes that failed to report their processes are skipped.
+func (s SysHealthInfo) TotalConnections() map[string]int {
+	totals := make(map[string]int, len(s.ProcInfo))
+	for _, p := range s.ProcInfo {
+		if p.Error != "" {
+			continue
+		}
+		totals[p.Addr] += p.TotalConnections()
+	}
+	return totals
+}
+
+// ProcessNode is a process with its child processes.
+type ProcessNode struct {
+	Process  SysProcess
+	Children []*ProcessNode
+}
+
+// Tree returns the processes as a tree ordered by pid. The returned root
+// is synthetic, only its Children are set, and it holds the processes
+// whose parent is not part of the list.
+func (s ServerProcInfo) Tree() *ProcessNode {
+	root := &ProcessNode{}
+	nodes := make([]*ProcessNode, len(s.Processes))
+	byPid := make(map[int32]*ProcessNode, len(s.Processes))
+	for i, p := range s.Processes {
+		nodes[i] = &ProcessNode{Process: p}
+		if _, ok := byPid[p.Pid]; !ok {
+			byPid[p.Pid] = nodes[i]
+		}
+	}
+	parentOf := make(map[*ProcessNode]*ProcessNode, len(nodes))
+	for _, n := range nodes {
+		ppid := n.Process.Ppid
+		if ppid == 0 {
+			ppid = n.Process.Parent
+		}
+		parent, ok := byPid[ppid]
+		if !ok || ppid == n.Process.Pid || ppid == 0 {
+			parent = root
+		}
+		parentOf[n] = parent
+	}
+
+	// Break parent cycles in malformed input by attaching the
+	// process closing the cycle to the root.
+	for _, n := range nodes {
+		seen := map[*ProcessNode]bool{}
+		for p := n; p != root && !seen[p]; p = parentOf[p] {
+			seen[p] = true
+			if seen[parentOf[p]] {
+				parentOf[p] = root
+			}
+		}
+	}
+
+	for _, n := range nodes {
+		parent := parentOf[n]
+		parent.Children = append(parent.Children, n)
+	}
+	var sortNodes func(n *ProcessNode)
+	sortNodes = func(n *ProcessNode) {
+		sort.SliceStable(n.Children, func(i, j int) bool {
+			return n.Children[i].Process.Pid < n.Children[j].Process.Pid
+		})
+		for _, c := range n.Children {
+			sortNodes(c)
+		}
+	}
+	sortNodes(root)
+	return root
+}
+
 // SysProcess - Includes process lvl information about a single process
 type SysProcess struct {
 	Pid             int32                       `json:"pid"`
@@ -216,6 +983,110 @@ func (sp SysProcess) GetOwner() string {
 	return sp.Username
 }
 
+// Args returns the command line arguments of the process. Null separated
+// command lines are split on the null bytes, others on white space, since
+// the arguments are joined by spaces when collected. Surrounding white
+// space and empty arguments are dropped.
+func (sp SysProcess) Args() []string {
+	if !strings.Contains(sp.CmdLine, "\x00") {
+		return append([]string{}, strings.Fields(sp.CmdLine)...)
+	}
+	args := []string{}
+	for _, arg := range strings.Split(sp.CmdLine, "\x00") {
+		if arg = strings.TrimSpace(arg); arg != "" {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+// ProcessState - state of a process
+type ProcessState string
+
+// Process states
+const (
+	ProcessStateRunning  ProcessState = "running"
+	ProcessStateSleeping ProcessState = "sleeping"
+	ProcessStateBlocked  ProcessState = "blocked"
+	ProcessStateIdle     ProcessState = "idle"
+	ProcessStateStopped  ProcessState = "stopped"
+	ProcessStateZombie   ProcessState = "zombie"
+	ProcessStateUnknown  ProcessState = "unknown"
+)
+
+// ParseProcessState parses a process status, either a single letter state
+// code as used by ps, e.g. "R" or "Z", or a state name as reported by
+// gopsutil, e.g. "running" or "zombie". Of several comma separated states
+// the first one is used. Unknown states return ProcessStateUnknown.
+func ParseProcessState(status string) ProcessState {
+	status, _, _ = strings.Cut(strings.TrimSpace(status), ",")
+	switch status {
+	case "R":
+		return ProcessStateRunning
+	case "S":
+		return ProcessStateSleeping
+	case "D", "U":
+		return ProcessStateBlocked
+	case "I":
+		return ProcessStateIdle
+	case "T", "t":
+		return ProcessStateStopped
+	case "Z":
+		return ProcessStateZombie
+	}
+	switch strings.ToLower(status) {
+	case "running":
+		return ProcessStateRunning
+	case "sleep", "sleeping":
+		return ProcessStateSleeping
+	case "blocked":
+		return ProcessStateBlocked
+	case "idle":
+		return ProcessStateIdle
+	case "stop", "stopped":
+		return ProcessStateStopped
+	case "zombie", "defunct":
+		return ProcessStateZombie
+	}
+	return ProcessStateUnknown
+}
+
+// State returns the parsed state of the process.
+func (sp SysProcess) State() ProcessState {
+	return ParseProcessState(sp.Status)
+}
+
+// IsZombie returns true if the process is a zombie (defunct) process.
+func (sp SysProcess) IsZombie() bool {
+	return sp.State() == ProcessStateZombie
+}
+
+// NodesWithoutMinio returns the addresses of nodes whose process list
+// has no process named processName, "minio" is used when empty. Nodes
+// that failed to report their processes are not included.
+func (s SysHealthInfo) NodesWithoutMinio(processName string) []string {
+	if processName == "" {
+		processName = "minio"
+	}
+	var nodes []string
+	for _, p := range s.ProcInfo {
+		if p.Error != "" {
+			continue
+		}
+		found := false
+		for _, proc := range p.Processes {
+			if proc.Name == processName || filepath.Base(proc.Exe) == processName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			nodes = append(nodes, p.Addr)
+		}
+	}
+	return nodes
+}
+
 // ServerMemInfo - Includes host virtual and swap mem information
 type ServerMemInfo struct {
 	Addr       string                 `json:"addr"`
@@ -224,6 +1095,39 @@ type ServerMemInfo struct {
 	Error      string                 `json:"error,omitempty"`
 }
 
+// MemPressure - memory pressure level of a node
+type MemPressure string
+
+// Memory pressure levels
+const (
+	MemUnknown  MemPressure = "unknown"
+	MemOK       MemPressure = "ok"
+	MemWarn     MemPressure = "warn"
+	MemCritical MemPressure = "critical"
+)
+
+// Pressure classifies the memory usage of the node. Used memory of 85%
+// or swap usage of 50% is a warning, used memory of 95% or swap usage of
+// 80% is critical. MemUnknown is returned without virtual memory stats,
+// swap is only considered when reported.
+func (s ServerMemInfo) Pressure() MemPressure {
+	if s.VirtualMem == nil {
+		return MemUnknown
+	}
+	used := s.VirtualMem.UsedPercent
+	var swap float64
+	if s.SwapMem != nil && s.SwapMem.Total > 0 {
+		swap = s.SwapMem.UsedPercent
+	}
+	switch {
+	case used >= 95 || swap >= 80:
+		return MemCritical
+	case used >= 85 || swap >= 50:
+		return MemWarn
+	}
+	return MemOK
+}
+
 // ServerOsInfo - Includes host os information
 type ServerOsInfo struct {
 	Addr    string                 `json:"addr"`
@@ -231,6 +1135,58 @@ type ServerOsInfo struct {
 	Sensors []host.TemperatureStat `json:"sensors,omitempty"`
 	Users   []host.UserStat        `json:"users,omitempty"`
 	Error   string                 `json:"error,omitempty"`
+
+	// HostTime is the time of the host clock when the info was collected,
+	// nil if not reported.
+	HostTime *time.Time `json:"hostTime,omitempty"`
+}
+
+// Uptime returns the uptime of the host when the info was collected.
+func (s ServerOsInfo) Uptime() (time.Duration, bool) {
+	if s.Info == nil {
+		return 0, false
+	}
+	return time.Duration(s.Info.Uptime) * time.Second, true
+}
+
+// BootTime returns the time the host was booted.
+func (s ServerOsInfo) BootTime() (time.Time, bool) {
+	if s.Info == nil || s.Info.BootTime == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(s.Info.BootTime), 0), true
+}
+
+// OverheatingSensors returns the sensors at or above their high or
+// critical temperature. Thresholds reported as zero are ignored.
+func (s ServerOsInfo) OverheatingSensors() []host.TemperatureStat {
+	var hot []host.TemperatureStat
+	for _, sensor := range s.Sensors {
+		if _, ok := sensorSeverity(sensor); ok {
+			hot = append(hot, sensor)
+		}
+	}
+	return hot
+}
+
+// ClockSkew returns the offset of the host clock of each node from at,
+// usually the report timestamp, positive if the host clock is ahead.
+// Nodes which failed to report their OS info or host time are omitted.
+func (s SysHealthInfo) ClockSkew(at time.Time) map[string]time.Duration {
+	skew := make(map[string]time.Duration, len(s.OsInfo))
+	for _, o := range s.OsInfo {
+		if o.Error != "" || o.HostTime == nil || o.HostTime.IsZero() {
+			continue
+		}
+		skew[o.Addr] = o.HostTime.Sub(at)
+	}
+	return skew
+}
+
+// ClockSkew returns the offset of the host clock of each node from the
+// report timestamp, see SysHealthInfo.ClockSkew.
+func (info HealthInfoV0) ClockSkew() map[string]time.Duration {
+	return info.Sys.ClockSkew(info.TimeStamp)
 }
 
 // ServerCPUInfo - Includes cpu and timer stats of each node of the MinIO cluster
@@ -241,6 +1197,68 @@ type ServerCPUInfo struct {
 	Error    string          `json:"error,omitempty"`
 }
 
+// IOWaitPercent returns the percentage of CPU time spent waiting for IO
+// between the prev sample and the time stats of this node. ok is false
+// if no CPU time elapsed between the two samples.
+func (s ServerCPUInfo) IOWaitPercent(prev []cpu.TimesStat) (percent float64, ok bool) {
+	var iowait, total float64
+	for _, t := range s.TimeStat {
+		iowait += t.Iowait
+		total += cpuTimesTotal(t)
+	}
+	for _, t := range prev {
+		iowait -= t.Iowait
+		total -= cpuTimesTotal(t)
+	}
+	if total <= 0 || iowait < 0 {
+		return 0, false
+	}
+	return iowait / total * 100, true
+}
+
+// PhysicalCPUs returns one entry per physical CPU, collapsing the entries
+// reported per logical CPU by physical id and model name. The first entry
+// of each CPU is kept with Cores set to the sum of the Cores of its
+// entries, i.e. the number of logical CPUs on Linux.
+func (s ServerCPUInfo) PhysicalCPUs() []cpu.InfoStat {
+	type cpuKey struct{ physicalID, model string }
+	var cpus []cpu.InfoStat
+	idx := make(map[cpuKey]int)
+	for _, c := range s.CPUStat {
+		key := cpuKey{physicalID: c.PhysicalID, model: c.ModelName}
+		if i, ok := idx[key]; ok {
+			cpus[i].Cores += c.Cores
+			continue
+		}
+		idx[key] = len(cpus)
+		cpus = append(cpus, c)
+	}
+	return cpus
+}
+
+// UtilizationPercent returns the percentage of CPU time spent busy in
+// user, system and irq time since boot, across all time stats.
+func (s ServerCPUInfo) UtilizationPercent() (float64, error) {
+	if len(s.TimeStat) == 0 {
+		return 0, errors.New("no CPU time stats available")
+	}
+	var busy, total float64
+	for _, t := range s.TimeStat {
+		busy += t.User + t.System + t.Irq
+		total += cpuTimesTotal(t)
+	}
+	if total <= 0 {
+		return 0, errors.New("no CPU time recorded")
+	}
+	return busy / total * 100, nil
+}
+
+// cpuTimesTotal returns the total CPU time of t. Guest time is not
+// included, since it is already accounted for in user and nice time.
+func cpuTimesTotal(t cpu.TimesStat) float64 {
+	return t.User + t.System + t.Idle + t.Nice + t.Iowait + t.Irq + t.Softirq + t.Steal
+}
+
 // MinioHealthInfoV0 - Includes MinIO confifuration information
 type MinioHealthInfoV0 struct {
 	Info   InfoMessage `json:"info,omitempty"`
@@ -257,6 +1275,72 @@ type ServerDiskHwInfo struct {
 	Error      string                           `json:"error,omitempty"`
 }
 
+// DeriveIOPS returns the read and write operations per second of each
+// device between two snapshots of the IO counters of a node, taken
+// interval apart. Devices missing from either snapshot, or whose counters
+// were reset in between, are not included.
+func DeriveIOPS(prev, cur ServerDiskHwInfo, interval time.Duration) map[string]float64 {
+	if interval <= 0 {
+		return nil
+	}
+	iops := make(map[string]float64, len(cur.Counters))
+	for dev, c := range cur.Counters {
+		p, ok := prev.Counters[dev]
+		if !ok || c.ReadCount < p.ReadCount || c.WriteCount < p.WriteCount {
+			continue
+		}
+		ops := (c.ReadCount - p.ReadCount) + (c.WriteCount - p.WriteCount)
+		iops[dev] = float64(ops) / interval.Seconds()
+	}
+	return iops
+}
+
+// IOPS returns the read and write operations per second of each device
+// since prev, a snapshot of the same node taken interval earlier, see
+// DeriveIOPS.
+func (s ServerDiskHwInfo) IOPS(prev ServerDiskHwInfo, interval time.Duration) map[string]float64 {
+	return DeriveIOPS(prev, s, interval)
+}
+
+// IsComplete returns true if both the usage and partition data of the
+// node are present, i.e. the collection wasn't interrupted. A node
+// reporting an error is never complete.
+func (s ServerDiskHwInfo) IsComplete() bool {
+	if s.Error != "" || len(s.Usage) == 0 || len(s.Partitions) == 0 {
+		return false
+	}
+	for _, u := range s.Usage {
+		if u == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// PartitionsWithoutSMART returns the partitions without any SCSI, NVMe
+// or ATA SMART data, e.g. drives behind a RAID controller.
+func (s ServerDiskHwInfo) PartitionsWithoutSMART() []PartitionStat {
+	var parts []PartitionStat
+	for _, p := range s.Partitions {
+		if p.SmartInfo.Scsi == nil && p.SmartInfo.Nvme == nil && p.SmartInfo.Ata == nil {
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
+
+// PartitionsByFS returns the partitions with the filesystem type fstype,
+// e.g. "xfs", matched case-insensitively.
+func (s ServerDiskHwInfo) PartitionsByFS(fstype string) []PartitionStat {
+	var parts []PartitionStat
+	for _, p := range s.Partitions {
+		if strings.EqualFold(p.Fstype, fstype) {
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
+
 // GetTotalCapacity gets the total capacity a server holds.
 func (s *ServerDiskHwInfo) GetTotalCapacity() (capacity uint64) {
 	for _, u := range s.Usage {
@@ -281,6 +1365,119 @@ func (s *ServerDiskHwInfo) GetTotalUsedCapacity() (capacity uint64) {
 	return
 }
 
+// UsedPercent returns the percentage of the capacity of the node in use,
+// 0 if the capacity is unknown.
+func (s ServerDiskHwInfo) UsedPercent() float64 {
+	var used, total uint64
+	for _, u := range s.Usage {
+		if u == nil {
+			continue
+		}
+		used += u.Used
+		total += u.Total
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(used) / float64(total) * 100
+}
+
+// DrivePaths returns the drives of all nodes as "addr:path", sorted and
+// without duplicates. The path is the mountpoint of the partition, or its
+// device if it has no mountpoint.
+func (s SysHealthInfo) DrivePaths() []string {
+	seen := make(map[string]struct{})
+	var paths []string
+	for _, hw := range s.DiskHwInfo {
+		for _, p := range hw.Partitions {
+			path := p.Mountpoint
+			if path == "" {
+				path = p.Device
+			}
+			if path == "" {
+				continue
+			}
+			drive := hw.Addr + ":" + path
+			if _, ok := seen[drive]; !ok {
+				seen[drive] = struct{}{}
+				paths = append(paths, drive)
+			}
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// DuplicateMountpoints returns the mountpoints reported by more than one
+// node, mapped to the sorted addresses of the nodes reporting them.
+func (s SysHealthInfo) DuplicateMountpoints() map[string][]string {
+	nodes := make(map[string]map[string]struct{})
+	for _, hw := range s.DiskHwInfo {
+		for _, p := range hw.Partitions {
+			if p.Mountpoint == "" {
+				continue
+			}
+			if nodes[p.Mountpoint] == nil {
+				nodes[p.Mountpoint] = make(map[string]struct{})
+			}
+			nodes[p.Mountpoint][hw.Addr] = struct{}{}
+		}
+	}
+	dups := make(map[string][]string)
+	for mnt, addrs := range nodes {
+		if len(addrs) < 2 {
+			continue
+		}
+		for addr := range addrs {
+			dups[mnt] = append(dups[mnt], addr)
+		}
+		sort.Strings(dups[mnt])
+	}
+	return dups
+}
+
+// ClusterCapacity gets the total, free and used capacity across all nodes.
+// Usage entries sharing the same node address and device are counted once,
+// entries without usage stats are skipped.
+func (s SysHealthInfo) ClusterCapacity() (total, free, used uint64) {
+	seen := make(map[string]struct{})
+	for _, hw := range s.DiskHwInfo {
+		devices := make(map[string]string, len(hw.Partitions))
+		for _, p := range hw.Partitions {
+			devices[p.Mountpoint] = p.Device
+		}
+		for _, u := range hw.Usage {
+			if u == nil {
+				continue
+			}
+			device, ok := devices[u.Path]
+			if !ok {
+				device = u.Path
+			}
+			key := hw.Addr + "|" + device
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			total += u.Total
+			free += u.Free
+			used += u.Used
+		}
+	}
+	return
+}
+
+// DegradedCapacity returns true if the free fraction of the cluster
+// capacity, as returned by ClusterCapacity, is below threshold, e.g. 0.1
+// for less than 10% free. It returns false if the capacity is unknown.
+func (s SysHealthInfo) DegradedCapacity(threshold float64) bool {
+	total, free, _ := s.ClusterCapacity()
+	if total == 0 {
+		return false
+	}
+	return float64(free)/float64(total) < threshold
+}
+
 // SmartInfo contains S.M.A.R.T data about the drive
 type SmartInfo struct {
 	Device string         `json:"device"`
@@ -312,6 +1509,119 @@ type SmartNvmeInfo struct {
 	HostWriteCommands           *big.Int `json:"hostWriteCommands,omitempty"`
 }
 
+// TemperatureCelsius parses the reported temperature and returns it in
+// degrees Celsius. Values such as "32 C", "305 K" and "90 F" are accepted,
+// a value without a unit is assumed to be in Celsius. ok is false when the
+// temperature is missing or cannot be parsed.
+func (s SmartNvmeInfo) TemperatureCelsius() (celsius float64, ok bool) {
+	t := strings.TrimSpace(s.Temperature)
+	if t == "" {
+		return 0, false
+	}
+
+	i := strings.IndexFunc(t, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+'
+	})
+	num, unit := t, ""
+	if i >= 0 {
+		num, unit = t[:i], strings.TrimSpace(t[i:])
+	}
+
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	switch strings.ToUpper(strings.TrimPrefix(unit, "°")) {
+	case "", "C", "CELSIUS":
+		return v, true
+	case "K", "KELVIN":
+		return v - 273.15, true
+	case "F", "FAHRENHEIT":
+		return (v - 32) * 5 / 9, true
+	}
+	return 0, false
+}
+
+// PowerOnDuration returns the power on hours of the drive as a duration,
+// capped at the largest representable duration. ok is false when the
+// power on hours are not reported.
+func (s SmartNvmeInfo) PowerOnDuration() (d time.Duration, ok bool) {
+	if s.PowerOnHours == nil || s.PowerOnHours.Sign() < 0 {
+		return 0, false
+	}
+	if !s.PowerOnHours.IsInt64() || s.PowerOnHours.Int64() > int64(math.MaxInt64/time.Hour) {
+		return math.MaxInt64, true
+	}
+	return time.Duration(s.PowerOnHours.Int64()) * time.Hour, true
+}
+
+// WriteRateBytesPerHour returns the average number of bytes written per
+// power on hour. ok is false when the written bytes or power on hours are
+// not reported, or the drive has not been powered on for an hour.
+func (s SmartNvmeInfo) WriteRateBytesPerHour() (rate float64, ok bool) {
+	if s.DataUnitsWrittenBytes == nil || s.PowerOnHours == nil || s.PowerOnHours.Sign() <= 0 {
+		return 0, false
+	}
+	rate, _ = new(big.Rat).SetFrac(s.DataUnitsWrittenBytes, s.PowerOnHours).Float64()
+	return rate, true
+}
+
+// SpareRemainingPercent returns the available spare capacity in percent,
+// parsed from SpareAvailable such as "100%".
+func (s SmartNvmeInfo) SpareRemainingPercent() (int, bool) {
+	return parseSmartPercent(s.SpareAvailable)
+}
+
+// nvmeCriticalWarnings are the descriptions of the bits of the NVMe
+// critical warning field, starting at bit 0.
+var nvmeCriticalWarnings = []string{
+	"available spare below threshold",
+	"temperature outside of threshold",
+	"reliability degraded",
+	"media in read-only mode",
+	"volatile memory backup failed",
+	"persistent memory region read-only",
+}
+
+// CriticalWarnings returns the descriptions of the warnings set in the
+// CriticalWarning bitmask, such as "reliability degraded". The bitmask is
+// read as hex, as reported by the server, e.g. "1f". Bits not
+// defined by the NVMe specification are ignored and an empty slice is
+// returned if the bitmask can't be parsed.
+func (s SmartNvmeInfo) CriticalWarnings() []string {
+	warnings := []string{}
+	v, ok := parseSmartHex(s.CriticalWarning)
+	if !ok {
+		return warnings
+	}
+	for bit, desc := range nvmeCriticalWarnings {
+		if v&(1<<bit) != 0 {
+			warnings = append(warnings, desc)
+		}
+	}
+	return warnings
+}
+
+// HasMediaErrors returns true if the drive reports media and data
+// integrity errors.
+func (s SmartNvmeInfo) HasMediaErrors() bool {
+	return s.MediaAndDataIntegrityErrors != nil && s.MediaAndDataIntegrityErrors.Sign() != 0
+}
+
+// MediaErrorCount returns the number of media and data integrity errors,
+// capped at the largest int64. ok is false when the count is not reported.
+func (s SmartNvmeInfo) MediaErrorCount() (n int64, ok bool) {
+	e := s.MediaAndDataIntegrityErrors
+	if e == nil {
+		return 0, false
+	}
+	if !e.IsInt64() {
+		return math.MaxInt64, true
+	}
+	return e.Int64(), true
+}
+
 // SmartScsiInfo contains SCSI drive Info
 type SmartScsiInfo struct {
 	CapacityBytes int64  `json:"scsiCapacityBytes,omitempty"`
@@ -337,6 +1647,21 @@ type SmartAtaInfo struct {
 	Transport             string `json:"transport,omitempty"`
 }
 
+// RPM returns the rotation rate of the drive in revolutions per minute,
+// parsed from RotationRate such as "7200 rpm". Solid state drives return
+// 0 and true, unknown rotation rates return 0 and false.
+func (s SmartAtaInfo) RPM() (int, bool) {
+	rate := strings.ToLower(strings.TrimSpace(s.RotationRate))
+	if strings.Contains(rate, "solid state") {
+		return 0, true
+	}
+	rpm, err := strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(rate, "rpm")))
+	if err != nil || rpm <= 0 {
+		return 0, false
+	}
+	return rpm, true
+}
+
 // PartitionStat - includes data from both shirou/psutil.diskHw.PartitionStat as well as SMART data
 type PartitionStat struct {
 	Device     string    `json:"device"`
@@ -346,6 +1671,339 @@ type PartitionStat struct {
 	SmartInfo  SmartInfo `json:"smartInfo,omitempty"`
 }
 
+// SMARTEnabled returns whether SMART monitoring is enabled for the drive,
+// i.e. ATA SMART support is enabled or NVMe or SCSI SMART data is reported.
+func (p PartitionStat) SMARTEnabled() bool {
+	switch {
+	case p.SmartInfo.Nvme != nil, p.SmartInfo.Scsi != nil:
+		return true
+	case p.SmartInfo.Ata != nil:
+		return p.SmartInfo.Ata.SmartSupportEnabled
+	}
+	return false
+}
+
+// DriveRef identifies a drive of a node in the cluster.
+type DriveRef struct {
+	Addr       string `json:"addr"`
+	Device     string `json:"device"`
+	Mountpoint string `json:"mountpoint,omitempty"`
+}
+
+// driveRisk holds the SMART signals used to rank drives by health.
+type driveRisk struct {
+	criticalWarning uint64
+	mediaErrors     uint64
+	spare           int // percent, 100 when not reported
+}
+
+func newDriveRisk(s SmartInfo) driveRisk {
+	r := driveRisk{spare: 100}
+	if s.Nvme == nil {
+		return r
+	}
+	r.criticalWarning, _ = parseSmartHex(s.Nvme.CriticalWarning)
+	if spare, ok := parseSmartPercent(s.Nvme.SpareAvailable); ok {
+		r.spare = spare
+	}
+	if e := s.Nvme.MediaAndDataIntegrityErrors; e != nil && e.IsUint64() {
+		r.mediaErrors = e.Uint64()
+	}
+	return r
+}
+
+// worse returns true if r ranks as less healthy than o. Critical warnings
+// rank first, followed by the number of media errors and the remaining spare.
+func (r driveRisk) worse(o driveRisk) bool {
+	if (r.criticalWarning != 0) != (o.criticalWarning != 0) {
+		return r.criticalWarning != 0
+	}
+	if r.mediaErrors != o.mediaErrors {
+		return r.mediaErrors > o.mediaErrors
+	}
+	return r.spare < o.spare
+}
+
+func (r driveRisk) String() string {
+	var reasons []string
+	if r.criticalWarning != 0 {
+		reasons = append(reasons, "critical warning 0x"+strconv.FormatUint(r.criticalWarning, 16))
+	}
+	if r.mediaErrors > 0 {
+		reasons = append(reasons, strconv.FormatUint(r.mediaErrors, 10)+" media errors")
+	}
+	if r.spare < 100 {
+		reasons = append(reasons, "spare "+strconv.Itoa(r.spare)+"%")
+	}
+	if len(reasons) == 0 {
+		return "no issues reported"
+	}
+	return strings.Join(reasons, ", ")
+}
+
+// MostCriticalDrive returns the drive with the worst SMART health in the
+// cluster along with a reason. Drives with critical warnings rank first,
+// followed by the highest media error count and the lowest available spare.
+// ok is false when no drive data is present.
+func (s SysHealthInfo) MostCriticalDrive() (drive DriveRef, reason string, ok bool) {
+	var worst driveRisk
+	for _, hw := range s.DiskHwInfo {
+		for _, p := range hw.Partitions {
+			risk := newDriveRisk(p.SmartInfo)
+			if ok && !risk.worse(worst) {
+				continue
+			}
+			drive = DriveRef{Addr: hw.Addr, Device: p.Device, Mountpoint: p.Mountpoint}
+			worst, ok = risk, true
+		}
+	}
+	if !ok {
+		return drive, "", false
+	}
+	return drive, worst.String(), true
+}
+
+// MostCriticalDrive returns the drive with the worst SMART health in the
+// cluster, see SysHealthInfo.MostCriticalDrive.
+func (info HealthInfoV0) MostCriticalDrive() (DriveRef, string, bool) {
+	return info.Sys.MostCriticalDrive()
+}
+
+// partitionRisk holds the signals used to rank the drives of a
+// HealthInfoV2 report, which carries no SMART data.
+type partitionRisk struct {
+	err  string
+	free float64 // fraction of free space, 1 when not reported
+}
+
+// worse returns true if r ranks as less healthy than o. Drives reporting
+// errors rank first, followed by the lowest free space.
+func (r partitionRisk) worse(o partitionRisk) bool {
+	if (r.err != "") != (o.err != "") {
+		return r.err != ""
+	}
+	return r.free < o.free
+}
+
+func (r partitionRisk) String() string {
+	var reasons []string
+	if r.err != "" {
+		reasons = append(reasons, "error: "+r.err)
+	}
+	if r.free < 1 {
+		reasons = append(reasons, strconv.FormatFloat(r.free*100, 'f', 0, 64)+"% free")
+	}
+	if len(reasons) == 0 {
+		return "no issues reported"
+	}
+	return strings.Join(reasons, ", ")
+}
+
+// MostCriticalDrive returns the drive with the worst health in the report
+// along with a reason. Unlike HealthInfoV0, V2 partitions carry no SMART
+// data, so critical warnings, spare and media errors are not available:
+// drives reporting a partition or drive perf error rank first, followed
+// by the lowest free space. ok is false when no partition data is present.
+func (info HealthInfoV2) MostCriticalDrive() (drive DriveRef, reason string, ok bool) {
+	perfErrs := map[DriveRef]string{}
+	for _, d := range info.Perf.Drives {
+		for _, p := range append(append([]DrivePerfInfo{}, d.SerialPerf...), d.ParallelPerf...) {
+			if p.Error != "" {
+				perfErrs[DriveRef{Addr: d.Addr, Mountpoint: p.Path}] = p.Error
+			}
+		}
+	}
+
+	var worst partitionRisk
+	for _, parts := range info.Sys.Partitions {
+		for _, p := range parts.Partitions {
+			risk := partitionRisk{err: p.Error, free: 1}
+			if risk.err == "" && p.Mountpoint != "" {
+				risk.err = perfErrs[DriveRef{Addr: parts.Addr, Mountpoint: p.Mountpoint}]
+			}
+			if p.SpaceTotal > 0 {
+				risk.free = float64(p.SpaceFree) / float64(p.SpaceTotal)
+			}
+			if ok && !risk.worse(worst) {
+				continue
+			}
+			drive = DriveRef{Addr: parts.Addr, Device: p.Device, Mountpoint: p.Mountpoint}
+			worst, ok = risk, true
+		}
+	}
+	if !ok {
+		return drive, "", false
+	}
+	return drive, worst.String(), true
+}
+
+// DrivesOlderThan returns the NVMe drives powered on for longer than age,
+// going by their SMART power on hours. Drives not reporting power on hours
+// are not included.
+func (s SysHealthInfo) DrivesOlderThan(age time.Duration) []DriveRef {
+	var drives []DriveRef
+	for _, hw := range s.DiskHwInfo {
+		for _, p := range hw.Partitions {
+			if p.SmartInfo.Nvme == nil {
+				continue
+			}
+			if d, ok := p.SmartInfo.Nvme.PowerOnDuration(); ok && d > age {
+				drives = append(drives, DriveRef{Addr: hw.Addr, Device: p.Device, Mountpoint: p.Mountpoint})
+			}
+		}
+	}
+	return drives
+}
+
+// TotalDataWritten returns the sum of the bytes written to the NVMe drives
+// of all nodes, going by their SMART data. Partitions reporting the serial
+// number of a drive already counted on the same node are counted once.
+func (s SysHealthInfo) TotalDataWritten() *big.Int {
+	total := new(big.Int)
+	for _, hw := range s.DiskHwInfo {
+		seen := make(map[string]struct{})
+		for _, p := range hw.Partitions {
+			nvme := p.SmartInfo.Nvme
+			if nvme == nil || nvme.DataUnitsWrittenBytes == nil {
+				continue
+			}
+			if nvme.SerialNum != "" {
+				if _, ok := seen[nvme.SerialNum]; ok {
+					continue
+				}
+				seen[nvme.SerialNum] = struct{}{}
+			}
+			total.Add(total, nvme.DataUnitsWrittenBytes)
+		}
+	}
+	return total
+}
+
+// DriveHealth - health of a drive going by its SMART data
+type DriveHealth string
+
+// Drive health states
+const (
+	DriveHealthy       DriveHealth = "healthy"
+	DriveHealthWarning DriveHealth = "warning"
+	DriveHealthFailing DriveHealth = "failing"
+	DriveHealthUnknown DriveHealth = "unknown"
+)
+
+// driveSpareThreshold is the spare percentage below which a drive
+// not reporting its spare threshold is in warning state.
+const driveSpareThreshold = 10
+
+// Health classifies the drive by its SMART data. NVMe drives with a
+// critical warning are failing, drives with media errors, spare capacity
+// below their threshold or ATA errors logged are in warning state. Drives
+// without SMART data are DriveHealthUnknown.
+func (s SmartInfo) Health() DriveHealth {
+	switch {
+	case s.Nvme != nil:
+		risk := newDriveRisk(s)
+		threshold, ok := parseSmartPercent(s.Nvme.SpareThreshold)
+		if !ok {
+			threshold = driveSpareThreshold
+		}
+		switch {
+		case risk.criticalWarning != 0:
+			return DriveHealthFailing
+		case risk.mediaErrors > 0 || risk.spare < threshold:
+			return DriveHealthWarning
+		}
+		return DriveHealthy
+	case s.Ata != nil:
+		log := strings.TrimSpace(s.Ata.ErrorLog)
+		if log != "" && !strings.EqualFold(log, "no errors logged") {
+			return DriveHealthWarning
+		}
+		return DriveHealthy
+	case s.Scsi != nil:
+		return DriveHealthy
+	}
+	return DriveHealthUnknown
+}
+
+// DriveHealthSummary returns the number of drives in the cluster
+// by their health, see SmartInfo.Health.
+func (s SysHealthInfo) DriveHealthSummary() map[DriveHealth]int {
+	summary := make(map[DriveHealth]int)
+	for _, hw := range s.DiskHwInfo {
+		for _, p := range hw.Partitions {
+			summary[p.SmartInfo.Health()]++
+		}
+	}
+	return summary
+}
+
+// FailureScore returns a drive failure risk score from 0 (no risk
+// signals) to 100 based on the NVMe SMART data, adding up:
+//
+//   - 40 points if any media and data integrity errors are reported.
+//   - Up to 30 points for depleted spare capacity, proportional to the
+//     spare used, or all 30 once the spare is at or below its threshold.
+//   - Up to 15 points for the temperature, rising linearly from 50°C
+//     to 70°C and above.
+//   - 1 point per 10 unsafe shutdowns, up to 15 points.
+//
+// Drives without NVMe SMART data score 0, since ATA and SCSI drives
+// don't report these signals.
+func (s SmartInfo) FailureScore() int {
+	if s.Nvme == nil {
+		return 0
+	}
+	score := 0.0
+	risk := newDriveRisk(s)
+	if s.Nvme.HasMediaErrors() {
+		score += 40
+	}
+	threshold, ok := parseSmartPercent(s.Nvme.SpareThreshold)
+	if !ok {
+		threshold = driveSpareThreshold
+	}
+	if risk.spare <= threshold {
+		score += 30
+	} else if risk.spare < 100 {
+		score += float64(100-risk.spare) * 30 / 100
+	}
+	if t, ok := s.Nvme.TemperatureCelsius(); ok && t > 50 {
+		score += math.Min(t-50, 20) * 15 / 20
+	}
+	if u := s.Nvme.UnsafeShutdowns; u != nil && u.Sign() > 0 {
+		if u.IsInt64() && u.Int64() < 150 {
+			score += float64(u.Int64() / 10)
+		} else {
+			score += 15
+		}
+	}
+	return int(math.Round(score))
+}
+
+// parseSmartPercent parses a SMART percentage such as "95%" or "95".
+func parseSmartPercent(s string) (int, bool) {
+	s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// parseSmartHex parses a SMART value in hex notation as written by the
+// server, such as "1f", with an optional "0x" prefix.
+func parseSmartHex(s string) (uint64, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s = s[2:]
+	}
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
 // PerfInfoV0 - Includes Drive and Net perf info for the entire MinIO cluster
 type PerfInfoV0 struct {
 	DriveInfo   []ServerDrivesInfo    `json:"drives,omitempty"`
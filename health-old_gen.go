@@ -0,0 +1,2212 @@
+package madmin
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *DrivePerfInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "path":
+			z.Path, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Path")
+				return
+			}
+		case "latency":
+			err = z.Latency.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Latency")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "throughput":
+			err = z.Throughput.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Throughput")
+				return
+			}
+			zb0001Mask |= 0x4
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x7 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Latency = Latency{}
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Throughput = Throughput{}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DrivePerfInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(4)
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "error"
+			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Error)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		}
+		// write "path"
+		err = en.Append(0xa4, 0x70, 0x61, 0x74, 0x68)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Path)
+		if err != nil {
+			err = msgp.WrapError(err, "Path")
+			return
+		}
+		// write "latency"
+		err = en.Append(0xa7, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79)
+		if err != nil {
+			return
+		}
+		err = z.Latency.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Latency")
+			return
+		}
+		// write "throughput"
+		err = en.Append(0xaa, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74)
+		if err != nil {
+			return
+		}
+		err = z.Throughput.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Throughput")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *DrivePerfInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(4)
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "error"
+			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Error)
+		}
+		// string "path"
+		o = append(o, 0xa4, 0x70, 0x61, 0x74, 0x68)
+		o = msgp.AppendString(o, z.Path)
+		// string "latency"
+		o = append(o, 0xa7, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79)
+		o, err = z.Latency.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Latency")
+			return
+		}
+		// string "throughput"
+		o = append(o, 0xaa, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74)
+		o, err = z.Throughput.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Throughput")
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DrivePerfInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "path":
+			z.Path, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Path")
+				return
+			}
+		case "latency":
+			bts, err = z.Latency.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Latency")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "throughput":
+			bts, err = z.Throughput.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Throughput")
+				return
+			}
+			zb0001Mask |= 0x4
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x7 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Latency = Latency{}
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Throughput = Throughput{}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DrivePerfInfo) Msgsize() (s int) {
+	s = 1 + 6 + msgp.StringPrefixSize + len(z.Error) + 5 + msgp.StringPrefixSize + len(z.Path) + 8 + z.Latency.Msgsize() + 11 + z.Throughput.Msgsize()
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DrivePerfInfos) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "serial_perf":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "SerialPerf")
+				return
+			}
+			if cap(z.SerialPerf) >= int(zb0002) {
+				z.SerialPerf = (z.SerialPerf)[:zb0002]
+			} else {
+				z.SerialPerf = make([]DrivePerfInfo, zb0002)
+			}
+			for za0001 := range z.SerialPerf {
+				err = z.SerialPerf[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "SerialPerf", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		case "parallel_perf":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ParallelPerf")
+				return
+			}
+			if cap(z.ParallelPerf) >= int(zb0003) {
+				z.ParallelPerf = (z.ParallelPerf)[:zb0003]
+			} else {
+				z.ParallelPerf = make([]DrivePerfInfo, zb0003)
+			}
+			for za0002 := range z.ParallelPerf {
+				err = z.ParallelPerf[za0002].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "ParallelPerf", za0002)
+					return
+				}
+			}
+			zb0001Mask |= 0x2
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.SerialPerf = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.ParallelPerf = nil
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DrivePerfInfos) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	if z.SerialPerf == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.ParallelPerf == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "serial_perf"
+			err = en.Append(0xab, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x66)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.SerialPerf)))
+			if err != nil {
+				err = msgp.WrapError(err, "SerialPerf")
+				return
+			}
+			for za0001 := range z.SerialPerf {
+				err = z.SerialPerf[za0001].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "SerialPerf", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "parallel_perf"
+			err = en.Append(0xad, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x66)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.ParallelPerf)))
+			if err != nil {
+				err = msgp.WrapError(err, "ParallelPerf")
+				return
+			}
+			for za0002 := range z.ParallelPerf {
+				err = z.ParallelPerf[za0002].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "ParallelPerf", za0002)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *DrivePerfInfos) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	if z.SerialPerf == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.ParallelPerf == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "serial_perf"
+			o = append(o, 0xab, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x66)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.SerialPerf)))
+			for za0001 := range z.SerialPerf {
+				o, err = z.SerialPerf[za0001].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "SerialPerf", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "parallel_perf"
+			o = append(o, 0xad, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x66)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.ParallelPerf)))
+			for za0002 := range z.ParallelPerf {
+				o, err = z.ParallelPerf[za0002].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "ParallelPerf", za0002)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DrivePerfInfos) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "serial_perf":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SerialPerf")
+				return
+			}
+			if cap(z.SerialPerf) >= int(zb0002) {
+				z.SerialPerf = (z.SerialPerf)[:zb0002]
+			} else {
+				z.SerialPerf = make([]DrivePerfInfo, zb0002)
+			}
+			for za0001 := range z.SerialPerf {
+				bts, err = z.SerialPerf[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "SerialPerf", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		case "parallel_perf":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ParallelPerf")
+				return
+			}
+			if cap(z.ParallelPerf) >= int(zb0003) {
+				z.ParallelPerf = (z.ParallelPerf)[:zb0003]
+			} else {
+				z.ParallelPerf = make([]DrivePerfInfo, zb0003)
+			}
+			for za0002 := range z.ParallelPerf {
+				bts, err = z.ParallelPerf[za0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ParallelPerf", za0002)
+					return
+				}
+			}
+			zb0001Mask |= 0x2
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.SerialPerf = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.ParallelPerf = nil
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DrivePerfInfos) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 12 + msgp.ArrayHeaderSize
+	for za0001 := range z.SerialPerf {
+		s += z.SerialPerf[za0001].Msgsize()
+	}
+	s += 14 + msgp.ArrayHeaderSize
+	for za0002 := range z.ParallelPerf {
+		s += z.ParallelPerf[za0002].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *HealthInfoV2) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 5 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "version":
+			z.Version, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		case "error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "timestamp":
+			z.TimeStamp, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "TimeStamp")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "sys":
+			err = z.Sys.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Sys")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "perf":
+			err = z.Perf.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Perf")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "minio":
+			err = z.Minio.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Minio")
+				return
+			}
+			zb0001Mask |= 0x10
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x1f {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.TimeStamp = (time.Time{})
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Sys = SysInfo{}
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Perf = PerfInfo{}
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.Minio = MinioHealthInfo{}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *HealthInfoV2) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(6)
+	var zb0001Mask uint8 /* 6 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.TimeStamp == (time.Time{}) {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "version"
+		err = en.Append(0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Version)
+		if err != nil {
+			err = msgp.WrapError(err, "Version")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "error"
+			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Error)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "timestamp"
+			err = en.Append(0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+			if err != nil {
+				return
+			}
+			err = en.WriteTime(z.TimeStamp)
+			if err != nil {
+				err = msgp.WrapError(err, "TimeStamp")
+				return
+			}
+		}
+		// write "sys"
+		err = en.Append(0xa3, 0x73, 0x79, 0x73)
+		if err != nil {
+			return
+		}
+		err = z.Sys.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Sys")
+			return
+		}
+		// write "perf"
+		err = en.Append(0xa4, 0x70, 0x65, 0x72, 0x66)
+		if err != nil {
+			return
+		}
+		err = z.Perf.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Perf")
+			return
+		}
+		// write "minio"
+		err = en.Append(0xa5, 0x6d, 0x69, 0x6e, 0x69, 0x6f)
+		if err != nil {
+			return
+		}
+		err = z.Minio.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Minio")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *HealthInfoV2) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(6)
+	var zb0001Mask uint8 /* 6 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.TimeStamp == (time.Time{}) {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "version"
+		o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+		o = msgp.AppendString(o, z.Version)
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "error"
+			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Error)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "timestamp"
+			o = append(o, 0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+			o = msgp.AppendTime(o, z.TimeStamp)
+		}
+		// string "sys"
+		o = append(o, 0xa3, 0x73, 0x79, 0x73)
+		o, err = z.Sys.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Sys")
+			return
+		}
+		// string "perf"
+		o = append(o, 0xa4, 0x70, 0x65, 0x72, 0x66)
+		o, err = z.Perf.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Perf")
+			return
+		}
+		// string "minio"
+		o = append(o, 0xa5, 0x6d, 0x69, 0x6e, 0x69, 0x6f)
+		o, err = z.Minio.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Minio")
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *HealthInfoV2) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 5 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "version":
+			z.Version, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		case "error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "timestamp":
+			z.TimeStamp, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TimeStamp")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "sys":
+			bts, err = z.Sys.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Sys")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "perf":
+			bts, err = z.Perf.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Perf")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "minio":
+			bts, err = z.Minio.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Minio")
+				return
+			}
+			zb0001Mask |= 0x10
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x1f {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.TimeStamp = (time.Time{})
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Sys = SysInfo{}
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Perf = PerfInfo{}
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.Minio = MinioHealthInfo{}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *HealthInfoV2) Msgsize() (s int) {
+	s = 1 + 8 + msgp.StringPrefixSize + len(z.Version) + 6 + msgp.StringPrefixSize + len(z.Error) + 10 + msgp.TimeSize + 4 + z.Sys.Msgsize() + 5 + z.Perf.Msgsize() + 6 + z.Minio.Msgsize()
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Latency) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "avg":
+			z.Avg, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Avg")
+				return
+			}
+		case "max":
+			z.Max, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Max")
+				return
+			}
+		case "min":
+			z.Min, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Min")
+				return
+			}
+		case "percentile_50":
+			z.Percentile50, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile50")
+				return
+			}
+		case "percentile_90":
+			z.Percentile90, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile90")
+				return
+			}
+		case "percentile_99":
+			z.Percentile99, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile99")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Latency) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "avg"
+	err = en.Append(0x86, 0xa3, 0x61, 0x76, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Avg)
+	if err != nil {
+		err = msgp.WrapError(err, "Avg")
+		return
+	}
+	// write "max"
+	err = en.Append(0xa3, 0x6d, 0x61, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Max)
+	if err != nil {
+		err = msgp.WrapError(err, "Max")
+		return
+	}
+	// write "min"
+	err = en.Append(0xa3, 0x6d, 0x69, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Min)
+	if err != nil {
+		err = msgp.WrapError(err, "Min")
+		return
+	}
+	// write "percentile_50"
+	err = en.Append(0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x35, 0x30)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Percentile50)
+	if err != nil {
+		err = msgp.WrapError(err, "Percentile50")
+		return
+	}
+	// write "percentile_90"
+	err = en.Append(0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x39, 0x30)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Percentile90)
+	if err != nil {
+		err = msgp.WrapError(err, "Percentile90")
+		return
+	}
+	// write "percentile_99"
+	err = en.Append(0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x39, 0x39)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Percentile99)
+	if err != nil {
+		err = msgp.WrapError(err, "Percentile99")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Latency) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "avg"
+	o = append(o, 0x86, 0xa3, 0x61, 0x76, 0x67)
+	o = msgp.AppendFloat64(o, z.Avg)
+	// string "max"
+	o = append(o, 0xa3, 0x6d, 0x61, 0x78)
+	o = msgp.AppendFloat64(o, z.Max)
+	// string "min"
+	o = append(o, 0xa3, 0x6d, 0x69, 0x6e)
+	o = msgp.AppendFloat64(o, z.Min)
+	// string "percentile_50"
+	o = append(o, 0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x35, 0x30)
+	o = msgp.AppendFloat64(o, z.Percentile50)
+	// string "percentile_90"
+	o = append(o, 0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x39, 0x30)
+	o = msgp.AppendFloat64(o, z.Percentile90)
+	// string "percentile_99"
+	o = append(o, 0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x39, 0x39)
+	o = msgp.AppendFloat64(o, z.Percentile99)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Latency) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "avg":
+			z.Avg, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Avg")
+				return
+			}
+		case "max":
+			z.Max, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Max")
+				return
+			}
+		case "min":
+			z.Min, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Min")
+				return
+			}
+		case "percentile_50":
+			z.Percentile50, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile50")
+				return
+			}
+		case "percentile_90":
+			z.Percentile90, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile90")
+				return
+			}
+		case "percentile_99":
+			z.Percentile99, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile99")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Latency) Msgsize() (s int) {
+	s = 1 + 4 + msgp.Float64Size + 4 + msgp.Float64Size + 4 + msgp.Float64Size + 14 + msgp.Float64Size + 14 + msgp.Float64Size + 14 + msgp.Float64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *NetPerfInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "remote_peers":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "RemotePeers")
+				return
+			}
+			if cap(z.RemotePeers) >= int(zb0002) {
+				z.RemotePeers = (z.RemotePeers)[:zb0002]
+			} else {
+				z.RemotePeers = make([]PeerNetPerfInfo, zb0002)
+			}
+			for za0001 := range z.RemotePeers {
+				err = z.RemotePeers[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "RemotePeers", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.RemotePeers = nil
+	}
+
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *NetPerfInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.RemotePeers == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "remote_peers"
+			err = en.Append(0xac, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.RemotePeers)))
+			if err != nil {
+				err = msgp.WrapError(err, "RemotePeers")
+				return
+			}
+			for za0001 := range z.RemotePeers {
+				err = z.RemotePeers[za0001].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "RemotePeers", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *NetPerfInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.RemotePeers == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "remote_peers"
+			o = append(o, 0xac, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.RemotePeers)))
+			for za0001 := range z.RemotePeers {
+				o, err = z.RemotePeers[za0001].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "RemotePeers", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *NetPerfInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "remote_peers":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RemotePeers")
+				return
+			}
+			if cap(z.RemotePeers) >= int(zb0002) {
+				z.RemotePeers = (z.RemotePeers)[:zb0002]
+			} else {
+				z.RemotePeers = make([]PeerNetPerfInfo, zb0002)
+			}
+			for za0001 := range z.RemotePeers {
+				bts, err = z.RemotePeers[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "RemotePeers", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.RemotePeers = nil
+	}
+
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *NetPerfInfo) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 13 + msgp.ArrayHeaderSize
+	for za0001 := range z.RemotePeers {
+		s += z.RemotePeers[za0001].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *PeerNetPerfInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "latency":
+			err = z.Latency.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Latency")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "throughput":
+			err = z.Throughput.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Throughput")
+				return
+			}
+			zb0001Mask |= 0x2
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Latency = Latency{}
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Throughput = Throughput{}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *PeerNetPerfInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		// write "latency"
+		err = en.Append(0xa7, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79)
+		if err != nil {
+			return
+		}
+		err = z.Latency.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Latency")
+			return
+		}
+		// write "throughput"
+		err = en.Append(0xaa, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74)
+		if err != nil {
+			return
+		}
+		err = z.Throughput.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Throughput")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *PeerNetPerfInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		// string "latency"
+		o = append(o, 0xa7, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79)
+		o, err = z.Latency.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Latency")
+			return
+		}
+		// string "throughput"
+		o = append(o, 0xaa, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74)
+		o, err = z.Throughput.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Throughput")
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *PeerNetPerfInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "latency":
+			bts, err = z.Latency.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Latency")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "throughput":
+			bts, err = z.Throughput.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Throughput")
+				return
+			}
+			zb0001Mask |= 0x2
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Latency = Latency{}
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Throughput = Throughput{}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *PeerNetPerfInfo) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 8 + z.Latency.Msgsize() + 11 + z.Throughput.Msgsize()
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *PerfInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "drives":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Drives")
+				return
+			}
+			if cap(z.Drives) >= int(zb0002) {
+				z.Drives = (z.Drives)[:zb0002]
+			} else {
+				z.Drives = make([]DrivePerfInfos, zb0002)
+			}
+			for za0001 := range z.Drives {
+				err = z.Drives[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Drives", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		case "net":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Net")
+				return
+			}
+			if cap(z.Net) >= int(zb0003) {
+				z.Net = (z.Net)[:zb0003]
+			} else {
+				z.Net = make([]NetPerfInfo, zb0003)
+			}
+			for za0002 := range z.Net {
+				err = z.Net[za0002].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Net", za0002)
+					return
+				}
+			}
+			zb0001Mask |= 0x2
+		case "net_parallel":
+			err = z.NetParallel.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NetParallel")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "collected_at":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "CollectedAt")
+					return
+				}
+				z.CollectedAt = nil
+			} else {
+				if z.CollectedAt == nil {
+					z.CollectedAt = new(time.Time)
+				}
+				*z.CollectedAt, err = dc.ReadTime()
+				if err != nil {
+					err = msgp.WrapError(err, "CollectedAt")
+					return
+				}
+			}
+			zb0001Mask |= 0x8
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xf {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Drives = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Net = nil
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.NetParallel = NetPerfInfo{}
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.CollectedAt = nil
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *PerfInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(4)
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	if z.Drives == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Net == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.CollectedAt == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "drives"
+			err = en.Append(0xa6, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Drives)))
+			if err != nil {
+				err = msgp.WrapError(err, "Drives")
+				return
+			}
+			for za0001 := range z.Drives {
+				err = z.Drives[za0001].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Drives", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "net"
+			err = en.Append(0xa3, 0x6e, 0x65, 0x74)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Net)))
+			if err != nil {
+				err = msgp.WrapError(err, "Net")
+				return
+			}
+			for za0002 := range z.Net {
+				err = z.Net[za0002].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Net", za0002)
+					return
+				}
+			}
+		}
+		// write "net_parallel"
+		err = en.Append(0xac, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c)
+		if err != nil {
+			return
+		}
+		err = z.NetParallel.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NetParallel")
+			return
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// write "collected_at"
+			err = en.Append(0xac, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74)
+			if err != nil {
+				return
+			}
+			if z.CollectedAt == nil {
+				err = en.WriteNil()
+				if err != nil {
+					return
+				}
+			} else {
+				err = en.WriteTime(*z.CollectedAt)
+				if err != nil {
+					err = msgp.WrapError(err, "CollectedAt")
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *PerfInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(4)
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	if z.Drives == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Net == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.CollectedAt == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "drives"
+			o = append(o, 0xa6, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Drives)))
+			for za0001 := range z.Drives {
+				o, err = z.Drives[za0001].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Drives", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "net"
+			o = append(o, 0xa3, 0x6e, 0x65, 0x74)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Net)))
+			for za0002 := range z.Net {
+				o, err = z.Net[za0002].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Net", za0002)
+					return
+				}
+			}
+		}
+		// string "net_parallel"
+		o = append(o, 0xac, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c)
+		o, err = z.NetParallel.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NetParallel")
+			return
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// string "collected_at"
+			o = append(o, 0xac, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74)
+			if z.CollectedAt == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendTime(o, *z.CollectedAt)
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *PerfInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "drives":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Drives")
+				return
+			}
+			if cap(z.Drives) >= int(zb0002) {
+				z.Drives = (z.Drives)[:zb0002]
+			} else {
+				z.Drives = make([]DrivePerfInfos, zb0002)
+			}
+			for za0001 := range z.Drives {
+				bts, err = z.Drives[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Drives", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		case "net":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Net")
+				return
+			}
+			if cap(z.Net) >= int(zb0003) {
+				z.Net = (z.Net)[:zb0003]
+			} else {
+				z.Net = make([]NetPerfInfo, zb0003)
+			}
+			for za0002 := range z.Net {
+				bts, err = z.Net[za0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Net", za0002)
+					return
+				}
+			}
+			zb0001Mask |= 0x2
+		case "net_parallel":
+			bts, err = z.NetParallel.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NetParallel")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "collected_at":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.CollectedAt = nil
+			} else {
+				if z.CollectedAt == nil {
+					z.CollectedAt = new(time.Time)
+				}
+				*z.CollectedAt, bts, err = msgp.ReadTimeBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CollectedAt")
+					return
+				}
+			}
+			zb0001Mask |= 0x8
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xf {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Drives = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Net = nil
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.NetParallel = NetPerfInfo{}
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.CollectedAt = nil
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *PerfInfo) Msgsize() (s int) {
+	s = 1 + 7 + msgp.ArrayHeaderSize
+	for za0001 := range z.Drives {
+		s += z.Drives[za0001].Msgsize()
+	}
+	s += 4 + msgp.ArrayHeaderSize
+	for za0002 := range z.Net {
+		s += z.Net[za0002].Msgsize()
+	}
+	s += 13 + z.NetParallel.Msgsize() + 13
+	if z.CollectedAt == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.TimeSize
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Throughput) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "avg":
+			z.Avg, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Avg")
+				return
+			}
+		case "max":
+			z.Max, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Max")
+				return
+			}
+		case "min":
+			z.Min, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Min")
+				return
+			}
+		case "percentile_50":
+			z.Percentile50, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile50")
+				return
+			}
+		case "percentile_90":
+			z.Percentile90, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile90")
+				return
+			}
+		case "percentile_99":
+			z.Percentile99, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile99")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Throughput) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "avg"
+	err = en.Append(0x86, 0xa3, 0x61, 0x76, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Avg)
+	if err != nil {
+		err = msgp.WrapError(err, "Avg")
+		return
+	}
+	// write "max"
+	err = en.Append(0xa3, 0x6d, 0x61, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Max)
+	if err != nil {
+		err = msgp.WrapError(err, "Max")
+		return
+	}
+	// write "min"
+	err = en.Append(0xa3, 0x6d, 0x69, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Min)
+	if err != nil {
+		err = msgp.WrapError(err, "Min")
+		return
+	}
+	// write "percentile_50"
+	err = en.Append(0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x35, 0x30)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Percentile50)
+	if err != nil {
+		err = msgp.WrapError(err, "Percentile50")
+		return
+	}
+	// write "percentile_90"
+	err = en.Append(0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x39, 0x30)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Percentile90)
+	if err != nil {
+		err = msgp.WrapError(err, "Percentile90")
+		return
+	}
+	// write "percentile_99"
+	err = en.Append(0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x39, 0x39)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Percentile99)
+	if err != nil {
+		err = msgp.WrapError(err, "Percentile99")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Throughput) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "avg"
+	o = append(o, 0x86, 0xa3, 0x61, 0x76, 0x67)
+	o = msgp.AppendUint64(o, z.Avg)
+	// string "max"
+	o = append(o, 0xa3, 0x6d, 0x61, 0x78)
+	o = msgp.AppendUint64(o, z.Max)
+	// string "min"
+	o = append(o, 0xa3, 0x6d, 0x69, 0x6e)
+	o = msgp.AppendUint64(o, z.Min)
+	// string "percentile_50"
+	o = append(o, 0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x35, 0x30)
+	o = msgp.AppendUint64(o, z.Percentile50)
+	// string "percentile_90"
+	o = append(o, 0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x39, 0x30)
+	o = msgp.AppendUint64(o, z.Percentile90)
+	// string "percentile_99"
+	o = append(o, 0xad, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x39, 0x39)
+	o = msgp.AppendUint64(o, z.Percentile99)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Throughput) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "avg":
+			z.Avg, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Avg")
+				return
+			}
+		case "max":
+			z.Max, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Max")
+				return
+			}
+		case "min":
+			z.Min, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Min")
+				return
+			}
+		case "percentile_50":
+			z.Percentile50, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile50")
+				return
+			}
+		case "percentile_90":
+			z.Percentile90, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile90")
+				return
+			}
+		case "percentile_99":
+			z.Percentile99, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Percentile99")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Throughput) Msgsize() (s int) {
+	s = 1 + 4 + msgp.Uint64Size + 4 + msgp.Uint64Size + 4 + msgp.Uint64Size + 14 + msgp.Uint64Size + 14 + msgp.Uint64Size + 14 + msgp.Uint64Size
+	return
+}
@@ -0,0 +1,1595 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	diskhw "github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/process"
+	"github.com/tinylib/msgp/msgp"
+)
+
+func TestSmartNvmeTemperatureCelsius(t *testing.T) {
+	testCases := []struct {
+		temp     string
+		expected float64
+		ok       bool
+	}{
+		{temp: "32 C", expected: 32, ok: true},
+		{temp: "32C", expected: 32, ok: true},
+		{temp: "32", expected: 32, ok: true},
+		{temp: "305 K", expected: 31.85, ok: true},
+		{temp: "90 F", expected: 32.22, ok: true},
+		{temp: "", ok: false},
+		{temp: "hot", ok: false},
+		{temp: "32 X", ok: false},
+	}
+
+	for i, testCase := range testCases {
+		celsius, ok := SmartNvmeInfo{Temperature: testCase.temp}.TemperatureCelsius()
+		if ok != testCase.ok {
+			t.Fatalf("Test %d: expected ok=%v, got %v", i+1, testCase.ok, ok)
+		}
+		if math.Abs(celsius-testCase.expected) > 0.01 {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.expected, celsius)
+		}
+	}
+}
+
+func TestSysHealthInfoClusterCapacity(t *testing.T) {
+	info := SysHealthInfo{
+		DiskHwInfo: []ServerDiskHwInfo{
+			{
+				Addr: "node1:9000",
+				Usage: []*diskhw.UsageStat{
+					{Path: "/mnt/disk1", Total: 100, Free: 60, Used: 40},
+					{Path: "/mnt/disk1-bind", Total: 100, Free: 60, Used: 40},
+					nil,
+				},
+				Partitions: []PartitionStat{
+					{Device: "/dev/sda", Mountpoint: "/mnt/disk1"},
+					{Device: "/dev/sda", Mountpoint: "/mnt/disk1-bind"},
+				},
+			},
+			{
+				Addr: "node2:9000",
+				Usage: []*diskhw.UsageStat{
+					{Path: "/mnt/disk1", Total: 200, Free: 50, Used: 150},
+				},
+			},
+		},
+	}
+
+	total, free, used := info.ClusterCapacity()
+	if total != 300 || free != 110 || used != 190 {
+		t.Fatalf("unexpected capacity total=%d free=%d used=%d", total, free, used)
+	}
+}
+
+func TestSysHealthInfoMostCriticalDrive(t *testing.T) {
+	if _, _, ok := (SysHealthInfo{}).MostCriticalDrive(); ok {
+		t.Fatal("expected no drive without drive data")
+	}
+
+	info := SysHealthInfo{
+		DiskHwInfo: []ServerDiskHwInfo{
+			{
+				Addr: "node1:9000",
+				Partitions: []PartitionStat{
+					{Device: "/dev/nvme0n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{
+						CriticalWarning: "0", SpareAvailable: "100%",
+					}}},
+					{Device: "/dev/nvme1n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{
+						CriticalWarning: "0", SpareAvailable: "20%",
+						MediaAndDataIntegrityErrors: big.NewInt(3),
+					}}},
+				},
+			},
+			{
+				Addr: "node2:9000",
+				Partitions: []PartitionStat{
+					{Device: "/dev/nvme0n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{
+						CriticalWarning: "a", SpareAvailable: "90%",
+					}}},
+					{Device: "/dev/nvme1n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{
+						CriticalWarning: "0", SpareAvailable: "5%",
+					}}},
+					{Device: "/dev/sda"},
+				},
+			},
+		},
+	}
+
+	drive, reason, ok := info.MostCriticalDrive()
+	if !ok {
+		t.Fatal("expected a drive to be selected")
+	}
+	expected := DriveRef{Addr: "node2:9000", Device: "/dev/nvme0n1"}
+	if drive != expected {
+		t.Fatalf("expected %v, got %v (%s)", expected, drive, reason)
+	}
+	if reason != "critical warning 0xa, spare 90%" {
+		t.Fatalf("unexpected reason %q", reason)
+	}
+
+	// Without critical warnings media errors rank before low spare.
+	info.DiskHwInfo[1].Partitions[0].SmartInfo.Nvme.CriticalWarning = "0"
+	drive, _, _ = info.MostCriticalDrive()
+	expected = DriveRef{Addr: "node1:9000", Device: "/dev/nvme1n1"}
+	if drive != expected {
+		t.Fatalf("expected %v, got %v", expected, drive)
+	}
+}
+
+func TestHealthInfoV2MostCriticalDrive(t *testing.T) {
+	if _, _, ok := (HealthInfoV2{}).MostCriticalDrive(); ok {
+		t.Fatal("expected no drive without partition data")
+	}
+
+	var info HealthInfoV2
+	info.Sys.Partitions = []Partitions{
+		{
+			NodeCommon: NodeCommon{Addr: "node1:9000"},
+			Partitions: []Partition{
+				{Device: "/dev/sda", Mountpoint: "/mnt/disk1", SpaceTotal: 100, SpaceFree: 90},
+				{Device: "/dev/sdb", Mountpoint: "/mnt/disk2", SpaceTotal: 100, SpaceFree: 5},
+			},
+		},
+		{
+			NodeCommon: NodeCommon{Addr: "node2:9000"},
+			Partitions: []Partition{
+				{Device: "/dev/sda", Mountpoint: "/mnt/disk1", SpaceTotal: 100, SpaceFree: 50},
+				{Device: "/dev/sdb", Mountpoint: "/mnt/disk2"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		perfErr  bool
+		expected DriveRef
+		reason   string
+	}{
+		{expected: DriveRef{Addr: "node1:9000", Device: "/dev/sdb", Mountpoint: "/mnt/disk2"}, reason: "5% free"},
+		{perfErr: true, expected: DriveRef{Addr: "node2:9000", Device: "/dev/sda", Mountpoint: "/mnt/disk1"}, reason: "error: drive not found, 50% free"},
+	}
+	for i, tc := range testCases {
+		if tc.perfErr {
+			info.Perf.Drives = []DrivePerfInfos{{
+				NodeCommon:   NodeCommon{Addr: "node2:9000"},
+				ParallelPerf: []DrivePerfInfo{{Path: "/mnt/disk1", Error: "drive not found"}},
+			}}
+		}
+		drive, reason, ok := info.MostCriticalDrive()
+		if !ok || drive != tc.expected || reason != tc.reason {
+			t.Fatalf("Test %d: expected %v (%s), got %v (%s, %v)", i+1, tc.expected, tc.reason, drive, reason, ok)
+		}
+	}
+}
+
+func TestLatencyThroughputPercentile(t *testing.T) {
+	l := Latency{Min: 1, Percentile50: 5, Percentile90: 9, Percentile99: 18, Max: 30}
+	testCases := []struct {
+		p        float64
+		expected float64
+	}{
+		{p: -1, expected: 1},
+		{p: 0, expected: 1},
+		{p: 25, expected: 3},
+		{p: 50, expected: 5},
+		{p: 70, expected: 7},
+		{p: 90, expected: 9},
+		{p: 95, expected: 14},
+		{p: 99, expected: 18},
+		{p: 99.9, expected: 30},
+	}
+	for _, testCase := range testCases {
+		if v := l.Percentile(testCase.p); math.Abs(v-testCase.expected) > 1e-9 {
+			t.Errorf("latency p%v: expected %v, got %v", testCase.p, testCase.expected, v)
+		}
+	}
+
+	tp := Throughput{Min: 100, Percentile50: 200, Percentile90: 300, Percentile99: 390, Max: 500}
+	if v := tp.Percentile(70); v != 250 {
+		t.Errorf("throughput p70: expected 250, got %v", v)
+	}
+	if v := tp.Percentile(100); v != 500 {
+		t.Errorf("throughput p100: expected 500, got %v", v)
+	}
+}
+
+func TestServerCPUInfoIOWaitPercent(t *testing.T) {
+	prev := []cpu.TimesStat{
+		{CPU: "cpu0", User: 100, System: 50, Idle: 800, Iowait: 50},
+		{CPU: "cpu1", User: 100, System: 50, Idle: 800, Iowait: 50},
+	}
+	info := ServerCPUInfo{
+		Addr: "node1:9000",
+		TimeStat: []cpu.TimesStat{
+			{CPU: "cpu0", User: 120, System: 60, Idle: 850, Iowait: 70, Guest: 10},
+			{CPU: "cpu1", User: 120, System: 60, Idle: 850, Iowait: 70, Guest: 10},
+		},
+	}
+
+	percent, ok := info.IOWaitPercent(prev)
+	if !ok {
+		t.Fatal("expected iowait to be computed")
+	}
+	// 40 of the 200 elapsed seconds were spent in iowait.
+	if math.Abs(percent-20) > 1e-9 {
+		t.Fatalf("expected 20%% iowait, got %v", percent)
+	}
+
+	if _, ok = info.IOWaitPercent(info.TimeStat); ok {
+		t.Fatal("expected no iowait without elapsed time")
+	}
+}
+
+func TestDecodeHealthInfo(t *testing.T) {
+	stream := `{"version":"2"}
+{"version":"2","sys":{"cpus":[{"addr":"node1:9000"}]}}
+{"version":"2","sys":{"cpus":[{"addr":"node1:9000"},{"addr":"node2:9000"}]},"perf":{"drives":[{"addr":"node1:9000"}]}}
+  `
+	info, err := DecodeHealthInfo(strings.NewReader(stream))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Version != HealthInfoVersion2 || len(info.Sys.CPUInfo) != 2 || len(info.Perf.Drives) != 1 {
+		t.Fatalf("unexpected health info %v", info)
+	}
+
+	// Cut off while sending the minio section of the last object.
+	stream = `{"version":"2"}
+{"version":"2","sys":{"cpus":[{"addr":"node1:9000"}]},"minio":{"info":{"mode":"onl`
+	info, err = DecodeHealthInfo(strings.NewReader(stream))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected %v, got %v", io.ErrUnexpectedEOF, err)
+	}
+	if len(info.Sys.CPUInfo) != 1 || info.Sys.CPUInfo[0].Addr != "node1:9000" {
+		t.Fatalf("expected partial sys section, got %v", info.Sys)
+	}
+}
+
+func TestSysHealthInfoNodesWithoutMinio(t *testing.T) {
+	info := SysHealthInfo{
+		ProcInfo: []ServerProcInfo{
+			{Addr: "node1:9000", Processes: []SysProcess{{Pid: 1, Name: "systemd"}, {Pid: 10, Name: "minio"}}},
+			{Addr: "node2:9000", Processes: []SysProcess{{Pid: 1, Name: "systemd"}, {Pid: 12, Exe: "/usr/local/bin/minio"}}},
+			{Addr: "node3:9000", Processes: []SysProcess{{Pid: 1, Name: "systemd"}}},
+			{Addr: "node4:9000", Error: "permission denied"},
+		},
+	}
+
+	nodes := info.NodesWithoutMinio("")
+	if !reflect.DeepEqual(nodes, []string{"node3:9000"}) {
+		t.Fatalf("expected node3:9000 only, got %v", nodes)
+	}
+}
+
+func TestSysHealthInfoNodeErrors(t *testing.T) {
+	info := SysHealthInfo{
+		CPUInfo:    []ServerCPUInfo{{Addr: "node1:9000"}, {Addr: "node2:9000", Error: "cpu timeout"}},
+		DiskHwInfo: []ServerDiskHwInfo{{Addr: "node1:9000"}, {Addr: "node2:9000", Error: "disk timeout"}},
+		MemInfo:    []ServerMemInfo{{Addr: "node3:9000", Error: "mem timeout"}},
+		ProcInfo:   []ServerProcInfo{{Addr: "node1:9000"}},
+	}
+
+	expected := map[string][]string{
+		"node2:9000": {"cpu timeout", "disk timeout"},
+		"node3:9000": {"mem timeout"},
+	}
+	if errs := info.NodeErrors(); !reflect.DeepEqual(errs, expected) {
+		t.Fatalf("expected %v, got %v", expected, errs)
+	}
+}
+
+func TestHealthInfoV2MarshalBinary(t *testing.T) {
+	report := `{
+ "version": "2",
+ "timestamp": "2024-05-01T10:20:30.123456789Z",
+ "sys": {
+  "partitions": [{"addr": "node1:9000", "partitions": [{"device": "/dev/sda", "major": 8, "space_total": 18446744073709551000, "space_free": 1024}]}],
+  "meminfo": [{"addr": "node1:9000", "total": 68719476736, "limit": 34359738368}],
+  "config": [{"addr": "node1:9000", "config": {"rlimit-max": 1048576, "thp-config": {"enabled": "never"}}}],
+  "osinfo": [{"addr": "node1:9000", "info": {"hostname": "node1", "uptime": 3600}, "sensors": [{"sensorKey": "nvme", "temperature": 41}]}],
+  "procinfo": [{"addr": "node1:9000", "pid": 42, "mem_info": {"rss": 4096}, "mem_maps": [{"path": "[heap]", "rss": 2048}], "times": {"cpu": "cpu", "user": 1.5}}]
+ },
+ "perf": {"drives": [{"addr": "node1:9000", "serial_perf": [{"path": "/mnt/disk1", "latency": {"avg": 0.0025, "percentile_99": 0.125}, "throughput": {"avg": 1073741824}}]}]},
+ "minio": {
+  "info": {"mode": "online", "deploymentID": "6fbd3f8a"},
+  "config": {"config": {"api": {"requests_max": "0"}}},
+  "replication": {"active_workers": {"curr": 2}, "replicated_buckets": [{"name": "bucket", "replication_info": {"config": {"Rules": [{"ID": "rule1", "Status": "Enabled"}], "Role": ""}}}]}
+ }
+}`
+
+	var fromJSON HealthInfoV2
+	if err := json.Unmarshal([]byte(report), &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := fromJSON.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromMsgp HealthInfoV2
+	if err = fromMsgp.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+
+	// msgpack keeps the instant, but not the zone of times.
+	if !fromMsgp.TimeStamp.Equal(fromJSON.TimeStamp) {
+		t.Fatalf("expected timestamp %v, got %v", fromJSON.TimeStamp, fromMsgp.TimeStamp)
+	}
+	fromMsgp.TimeStamp = fromMsgp.TimeStamp.UTC()
+	for i := range fromMsgp.Minio.Replication.RDReplicatedBuckets {
+		resync := &fromMsgp.Minio.Replication.RDReplicatedBuckets[i].ReplicationInfo.Resync
+		resync.StartTime = resync.StartTime.UTC()
+	}
+	if !reflect.DeepEqual(fromJSON, fromMsgp) {
+		t.Fatalf("msgpack round trip mismatch:\n%s\n%s", fromJSON, fromMsgp)
+	}
+}
+
+func TestHealthInfoV2MsgpFormat(t *testing.T) {
+	// Fields kept in their JSON form must fail to decode when corrupt.
+	corrupt := msgp.AppendMapHeader(nil, 1)
+	corrupt = msgp.AppendString(corrupt, "replication")
+	corrupt = msgp.AppendString(corrupt, "{not json")
+	var minio MinioHealthInfo
+	if _, err := minio.UnmarshalMsg(corrupt); err == nil {
+		t.Fatal("expected corrupt replication info to fail")
+	}
+	corrupt = msgp.AppendMapHeader(nil, 1)
+	corrupt = msgp.AppendString(corrupt, "mem_maps")
+	corrupt = msgp.AppendArrayHeader(corrupt, 1)
+	corrupt = msgp.AppendString(corrupt, "{not json")
+	var proc ProcInfo
+	if _, err := proc.UnmarshalMsg(corrupt); err == nil {
+		t.Fatal("expected corrupt memory maps to fail")
+	}
+
+	// Decoding into a used value must not keep omitted fields.
+	data, err := HealthInfoV2{Version: HealthInfoVersion}.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := HealthInfoV2{Error: "stale", Minio: MinioHealthInfo{Error: "stale"}}
+	if err = info.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if info.Error != "" || info.Minio.Error != "" {
+		t.Fatalf("expected omitted errors to be cleared, got %q and %q", info.Error, info.Minio.Error)
+	}
+}
+
+func TestDrivePerfInfosSlowestDrive(t *testing.T) {
+	perf := DrivePerfInfos{
+		SerialPerf: []DrivePerfInfo{
+			{Path: "/mnt/disk1", Latency: Latency{Percentile99: 0.02}},
+			{Path: "/mnt/disk2", Error: "drive not found", Latency: Latency{Percentile99: 9}},
+		},
+		ParallelPerf: []DrivePerfInfo{
+			{Path: "/mnt/disk3", Latency: Latency{Percentile99: 0.5}},
+		},
+	}
+	slowest, ok := perf.SlowestDrive()
+	if !ok || slowest.Path != "/mnt/disk3" {
+		t.Fatalf("expected /mnt/disk3, got %v (%v)", slowest.Path, ok)
+	}
+
+	perf = DrivePerfInfos{SerialPerf: []DrivePerfInfo{{Path: "/mnt/disk1", Error: "timeout"}}}
+	if _, ok = perf.SlowestDrive(); ok {
+		t.Fatal("expected no slowest drive without healthy drives")
+	}
+}
+
+func TestThroughputString(t *testing.T) {
+	tp := Throughput{
+		Avg:          1288490189, // 1.2 GiB
+		Percentile50: 512,
+		Percentile90: 1536,
+		Percentile99: 5 << 40,
+	}
+	expected := "avg=1.20 GiB/s p50=512 B/s p90=1.50 KiB/s p99=5.00 TiB/s"
+	if s := tp.String(); s != expected {
+		t.Fatalf("expected %q, got %q", expected, s)
+	}
+}
+
+func TestNetPerfInfoSelf(t *testing.T) {
+	perf := NetPerfInfo{
+		NodeCommon: NodeCommon{Addr: "node1:9000"},
+		RemotePeers: []PeerNetPerfInfo{
+			{NodeCommon: NodeCommon{Addr: "node2:9000"}},
+			{NodeCommon: NodeCommon{Addr: "node1:9001"}},
+			{NodeCommon: NodeCommon{Addr: "http://node1"}},
+		},
+	}
+	if self := perf.Self(); self == nil || self.Addr != "http://node1" {
+		t.Fatalf("expected to find self by host, got %v", self)
+	}
+
+	perf.Addr = "localhost:9000"
+	perf.RemotePeers = []PeerNetPerfInfo{{NodeCommon: NodeCommon{Addr: "localhost:9001"}}}
+	if self := perf.Self(); self != nil {
+		t.Fatalf("expected a node on another port not to match, got %v", self)
+	}
+	perf.Addr = "node1:9000"
+	perf.RemotePeers = []PeerNetPerfInfo{
+		{NodeCommon: NodeCommon{Addr: "node2:9000"}},
+		{NodeCommon: NodeCommon{Addr: "http://NODE1:9000/"}},
+	}
+	if self := perf.Self(); self == nil || self.Addr != "http://NODE1:9000/" {
+		t.Fatalf("expected to find self by host and port, got %v", self)
+	}
+
+	perf.RemotePeers = append(perf.RemotePeers, PeerNetPerfInfo{NodeCommon: NodeCommon{Addr: "node1:9000"}})
+	if self := perf.Self(); self == nil || self.Addr != "node1:9000" {
+		t.Fatalf("expected to find self by address, got %v", self)
+	}
+
+	perf.RemotePeers = perf.RemotePeers[:1]
+	if self := perf.Self(); self != nil {
+		t.Fatalf("expected no self, got %v", self)
+	}
+}
+
+func TestServerProcInfoTree(t *testing.T) {
+	info := ServerProcInfo{
+		Processes: []SysProcess{
+			{Pid: 12, Ppid: 10},
+			{Pid: 10, Ppid: 1},
+			{Pid: 1},
+			{Pid: 11, Parent: 10},
+			{Pid: 20, Ppid: 99},
+			{Pid: 30, Ppid: 31},
+			{Pid: 31, Ppid: 30},
+		},
+	}
+
+	var render func(n *ProcessNode) string
+	render = func(n *ProcessNode) string {
+		var parts []string
+		for _, c := range n.Children {
+			parts = append(parts, strconv.Itoa(int(c.Process.Pid))+render(c))
+		}
+		if len(parts) == 0 {
+			return ""
+		}
+		return "(" + strings.Join(parts, " ") + ")"
+	}
+
+	want := "(1(10(11 12)) 20 31(30))"
+	if got := render(info.Tree()); got != want {
+		t.Fatalf("expected tree %s, got %s", want, got)
+	}
+	if got := render(ServerProcInfo{}.Tree()); got != "" {
+		t.Fatalf("expected empty tree, got %s", got)
+	}
+}
+
+func TestTotalConnections(t *testing.T) {
+	info := SysHealthInfo{
+		ProcInfo: []ServerProcInfo{
+			{Addr: "node1:9000", Processes: []SysProcess{{ConnectionCount: 3}, {ConnectionCount: 4}}},
+			{Addr: "node2:9000", Processes: []SysProcess{{ConnectionCount: 1}}},
+			{Addr: "node3:9000", Processes: []SysProcess{{ConnectionCount: 9}}, Error: "permission denied"},
+		},
+	}
+	want := map[string]int{"node1:9000": 7, "node2:9000": 1}
+	if got := info.TotalConnections(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestCPUUtilizationPercent(t *testing.T) {
+	info := ServerCPUInfo{
+		TimeStat: []cpu.TimesStat{
+			{User: 20, System: 10, Irq: 5, Idle: 60, Iowait: 5},
+			{User: 10, System: 5, Idle: 85},
+		},
+	}
+	got, err := info.UtilizationPercent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got-25) > 1e-9 {
+		t.Fatalf("expected 25%%, got %v", got)
+	}
+	if _, err := (ServerCPUInfo{}).UtilizationPercent(); err == nil {
+		t.Fatal("expected error without time stats")
+	}
+	if _, err := (ServerCPUInfo{TimeStat: []cpu.TimesStat{{}}}).UtilizationPercent(); err == nil {
+		t.Fatal("expected error without recorded time")
+	}
+}
+
+func TestServerMemInfoPressure(t *testing.T) {
+	testCases := []struct {
+		virtual *mem.VirtualMemoryStat
+		swap    *mem.SwapMemoryStat
+		want    MemPressure
+	}{
+		{want: MemUnknown},
+		{swap: &mem.SwapMemoryStat{Total: 100, UsedPercent: 90}, want: MemUnknown},
+		{virtual: &mem.VirtualMemoryStat{UsedPercent: 40}, want: MemOK},
+		{virtual: &mem.VirtualMemoryStat{UsedPercent: 90}, want: MemWarn},
+		{virtual: &mem.VirtualMemoryStat{UsedPercent: 97}, want: MemCritical},
+		{virtual: &mem.VirtualMemoryStat{UsedPercent: 40}, swap: &mem.SwapMemoryStat{Total: 100, UsedPercent: 60}, want: MemWarn},
+		{virtual: &mem.VirtualMemoryStat{UsedPercent: 40}, swap: &mem.SwapMemoryStat{Total: 100, UsedPercent: 85}, want: MemCritical},
+		{virtual: &mem.VirtualMemoryStat{UsedPercent: 40}, swap: &mem.SwapMemoryStat{UsedPercent: 100}, want: MemOK},
+	}
+
+	for i, tc := range testCases {
+		info := ServerMemInfo{VirtualMem: tc.virtual, SwapMem: tc.swap}
+		if got := info.Pressure(); got != tc.want {
+			t.Fatalf("case %d: expected %s, got %s", i+1, tc.want, got)
+		}
+	}
+}
+
+func TestHealthInfoV2Redact(t *testing.T) {
+	info := HealthInfoV2{
+		Sys: SysInfo{
+			ProcInfo: []ProcInfo{{
+				PID:     1,
+				CmdLine: "minio server --secret",
+				MemMaps: []process.MemoryMapsStat{{Path: "/usr/bin/minio"}},
+			}},
+		},
+		Minio: MinioHealthInfo{
+			Info: MinioInfo{
+				Servers: []ServerInfo{{Endpoint: "node1:9000", MinioEnvVars: map[string]string{"MINIO_ROOT_USER": "admin"}}},
+			},
+		},
+	}
+
+	redacted := info.Redact(RedactOptions{DropMemMaps: true, DropEnv: true, DropCmdLine: true})
+	proc := redacted.Sys.ProcInfo[0]
+	if proc.PID != 1 || proc.CmdLine != "" || proc.MemMaps != nil {
+		t.Fatalf("unexpected redacted process %+v", proc)
+	}
+	srv := redacted.Minio.Info.Servers[0]
+	if srv.Endpoint != "node1:9000" || srv.MinioEnvVars != nil {
+		t.Fatalf("unexpected redacted server %+v", srv)
+	}
+
+	if info.Sys.ProcInfo[0].CmdLine == "" || info.Sys.ProcInfo[0].MemMaps == nil {
+		t.Fatal("original process info was modified")
+	}
+	if info.Minio.Info.Servers[0].MinioEnvVars == nil {
+		t.Fatal("original server info was modified")
+	}
+
+	partial := info.Redact(RedactOptions{DropCmdLine: true})
+	if partial.Sys.ProcInfo[0].MemMaps == nil || partial.Minio.Info.Servers[0].MinioEnvVars == nil {
+		t.Fatal("unselected fields were dropped")
+	}
+}
+
+func TestServerOsInfoUptime(t *testing.T) {
+	info := ServerOsInfo{Info: &host.InfoStat{Uptime: 3600, BootTime: 1700000000}}
+	if up, ok := info.Uptime(); !ok || up != time.Hour {
+		t.Fatalf("expected uptime %v, got %v (%v)", time.Hour, up, ok)
+	}
+	if boot, ok := info.BootTime(); !ok || !boot.Equal(time.Unix(1700000000, 0)) {
+		t.Fatalf("unexpected boot time %v (%v)", boot, ok)
+	}
+	if _, ok := (ServerOsInfo{}).Uptime(); ok {
+		t.Fatal("expected no uptime without info")
+	}
+	if _, ok := (ServerOsInfo{}).BootTime(); ok {
+		t.Fatal("expected no boot time without info")
+	}
+}
+
+func TestOverheatingSensors(t *testing.T) {
+	info := ServerOsInfo{
+		Sensors: []host.TemperatureStat{
+			{SensorKey: "cpu0", Temperature: 50, High: 80, Critical: 95},
+			{SensorKey: "cpu1", Temperature: 85, High: 80, Critical: 95},
+			{SensorKey: "nvme0", Temperature: 96, Critical: 95},
+			{SensorKey: "acpi", Temperature: 120},
+		},
+	}
+	var keys []string
+	for _, sensor := range info.OverheatingSensors() {
+		keys = append(keys, sensor.SensorKey)
+	}
+	if want := []string{"cpu1", "nvme0"}; !reflect.DeepEqual(keys, want) {
+		t.Fatalf("expected %v, got %v", want, keys)
+	}
+}
+
+func TestPerfInfoWriteDriveCSV(t *testing.T) {
+	perf := PerfInfo{
+		Drives: []DrivePerfInfos{
+			{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				SerialPerf: []DrivePerfInfo{{
+					Path:       "/mnt/drive1",
+					Latency:    Latency{Percentile50: 0.5, Percentile90: 1, Percentile99: 2.5},
+					Throughput: Throughput{Avg: 100, Percentile50: 90, Percentile90: 110, Percentile99: 120},
+				}},
+				ParallelPerf: []DrivePerfInfo{{Path: "/mnt/drive2", Error: "drive offline"}},
+			},
+			{NodeCommon: NodeCommon{Addr: "node2:9000", Error: "timeout"}},
+		},
+	}
+
+	var buf strings.Builder
+	if err := perf.WriteDriveCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `addr,mode,path,latency_p50,latency_p90,latency_p99,throughput_avg,throughput_p50,throughput_p90,throughput_p99,error
+node1:9000,serial,/mnt/drive1,0.5,1,2.5,100,90,110,120,
+node1:9000,parallel,/mnt/drive2,0,0,0,0,0,0,0,drive offline
+node2:9000,,,,,,,,,,timeout
+`
+	if buf.String() != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, buf.String())
+	}
+}
+
+func TestPartitionsWithoutSMART(t *testing.T) {
+	info := ServerDiskHwInfo{
+		Partitions: []PartitionStat{
+			{Device: "/dev/nvme0n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{}}},
+			{Device: "/dev/sda", SmartInfo: SmartInfo{Device: "/dev/sda"}},
+			{Device: "/dev/sdb", SmartInfo: SmartInfo{Ata: &SmartAtaInfo{}}},
+			{Device: "/dev/sdc", SmartInfo: SmartInfo{Scsi: &SmartScsiInfo{}}},
+			{Device: "/dev/md0"},
+		},
+	}
+	var devices []string
+	for _, p := range info.PartitionsWithoutSMART() {
+		devices = append(devices, p.Device)
+	}
+	if want := []string{"/dev/sda", "/dev/md0"}; !reflect.DeepEqual(devices, want) {
+		t.Fatalf("expected %v, got %v", want, devices)
+	}
+}
+
+func TestSmartNvmePowerOnDuration(t *testing.T) {
+	huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+	testCases := []struct {
+		hours *big.Int
+		want  time.Duration
+		ok    bool
+	}{
+		{hours: nil},
+		{hours: big.NewInt(-1)},
+		{hours: big.NewInt(0), want: 0, ok: true},
+		{hours: big.NewInt(24), want: 24 * time.Hour, ok: true},
+		{hours: huge, want: math.MaxInt64, ok: true},
+	}
+	for i, tc := range testCases {
+		got, ok := SmartNvmeInfo{PowerOnHours: tc.hours}.PowerOnDuration()
+		if got != tc.want || ok != tc.ok {
+			t.Fatalf("case %d: expected %v (%v), got %v (%v)", i+1, tc.want, tc.ok, got, ok)
+		}
+	}
+}
+
+func TestDrivesOlderThan(t *testing.T) {
+	info := SysHealthInfo{
+		DiskHwInfo: []ServerDiskHwInfo{{
+			Addr: "node1:9000",
+			Partitions: []PartitionStat{
+				{Device: "/dev/nvme0n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{PowerOnHours: big.NewInt(50000)}}},
+				{Device: "/dev/nvme1n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{PowerOnHours: big.NewInt(100)}}},
+				{Device: "/dev/nvme2n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{}}},
+				{Device: "/dev/sda"},
+			},
+		}},
+	}
+	want := []DriveRef{{Addr: "node1:9000", Device: "/dev/nvme0n1"}}
+	if got := info.DrivesOlderThan(5 * 365 * 24 * time.Hour); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSmartNvmeWear(t *testing.T) {
+	info := SmartNvmeInfo{
+		DataUnitsWrittenBytes: big.NewInt(1 << 40),
+		PowerOnHours:          big.NewInt(1024),
+		SpareAvailable:        "97%",
+	}
+	if rate, ok := info.WriteRateBytesPerHour(); !ok || rate != 1<<30 {
+		t.Fatalf("expected 1GiB/h, got %v (%v)", rate, ok)
+	}
+	if spare, ok := info.SpareRemainingPercent(); !ok || spare != 97 {
+		t.Fatalf("expected 97%%, got %v (%v)", spare, ok)
+	}
+	if spare, ok := (SmartNvmeInfo{SpareAvailable: "80"}).SpareRemainingPercent(); !ok || spare != 80 {
+		t.Fatalf("expected 80%%, got %v (%v)", spare, ok)
+	}
+
+	for i, missing := range []SmartNvmeInfo{
+		{},
+		{DataUnitsWrittenBytes: big.NewInt(1)},
+		{DataUnitsWrittenBytes: big.NewInt(1), PowerOnHours: big.NewInt(0)},
+	} {
+		if _, ok := missing.WriteRateBytesPerHour(); ok {
+			t.Fatalf("case %d: expected no write rate", i+1)
+		}
+	}
+	if _, ok := (SmartNvmeInfo{SpareAvailable: "n/a"}).SpareRemainingPercent(); ok {
+		t.Fatal("expected invalid spare to fail")
+	}
+}
+
+func TestPerfInfoApproxEqual(t *testing.T) {
+	newPerf := func(latency float64, throughput uint64, driveErr string) PerfInfo {
+		return PerfInfo{
+			Drives: []DrivePerfInfos{{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				SerialPerf: []DrivePerfInfo{{
+					Path:       "/mnt/drive1",
+					Error:      driveErr,
+					Latency:    Latency{Avg: latency, Percentile99: latency * 2},
+					Throughput: Throughput{Avg: throughput},
+				}},
+			}},
+			Net: []NetPerfInfo{{
+				NodeCommon:  NodeCommon{Addr: "node1:9000"},
+				RemotePeers: []PeerNetPerfInfo{{NodeCommon: NodeCommon{Addr: "node2:9000"}, Latency: Latency{Avg: latency}}},
+			}},
+		}
+	}
+
+	base := newPerf(0.010, 1000000, "")
+	testCases := []struct {
+		other PerfInfo
+		want  bool
+	}{
+		{other: base, want: true},
+		{other: newPerf(0.01001, 1000500, ""), want: true},
+		{other: newPerf(0.011, 1000000, ""), want: false},
+		{other: newPerf(0.010, 1100000, ""), want: false},
+		{other: newPerf(0.010, 1000000, "drive offline"), want: false},
+		{other: PerfInfo{}, want: false},
+	}
+	for i, tc := range testCases {
+		if got := base.ApproxEqual(tc.other, 0.01); got != tc.want {
+			t.Fatalf("case %d: expected %v, got %v", i+1, tc.want, got)
+		}
+	}
+
+	other := newPerf(0.010, 1000000, "")
+	other.Net[0].RemotePeers[0].Addr = "node3:9000"
+	if base.ApproxEqual(other, 0.01) {
+		t.Fatal("expected different peers to differ")
+	}
+}
+
+func TestPeerNetPerfInfoAsymmetry(t *testing.T) {
+	perf := PerfInfo{
+		Net: []NetPerfInfo{
+			{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				RemotePeers: []PeerNetPerfInfo{
+					{NodeCommon: NodeCommon{Addr: "node2:9000"}, Throughput: Throughput{Avg: 500}},
+					{NodeCommon: NodeCommon{Addr: "node3:9000"}, Throughput: Throughput{Avg: 500}},
+				},
+			},
+			{
+				NodeCommon:  NodeCommon{Addr: "node2:9000"},
+				RemotePeers: []PeerNetPerfInfo{{NodeCommon: NodeCommon{Addr: "node1:9000"}, Throughput: Throughput{Avg: 1000}}},
+			},
+		},
+	}
+
+	peer := perf.Net[0].RemotePeers[0]
+	if ratio, ok := peer.Asymmetry(perf.ReversePeer("node1:9000", peer.Addr)); !ok || ratio != 0.5 {
+		t.Fatalf("expected ratio 0.5, got %v (%v)", ratio, ok)
+	}
+	peer = perf.Net[0].RemotePeers[1]
+	if ratio, ok := peer.Asymmetry(perf.ReversePeer("node1:9000", peer.Addr)); ok || ratio != 1 {
+		t.Fatalf("expected no reverse measurement, got %v (%v)", ratio, ok)
+	}
+	failed := PeerNetPerfInfo{NodeCommon: NodeCommon{Error: "timeout"}}
+	if ratio, ok := perf.Net[0].RemotePeers[0].Asymmetry(&failed); ok || ratio != 1 {
+		t.Fatalf("expected failed reverse to be ignored, got %v (%v)", ratio, ok)
+	}
+}
+
+func TestDuplicateMountpoints(t *testing.T) {
+	info := SysHealthInfo{
+		DiskHwInfo: []ServerDiskHwInfo{
+			{Addr: "node2:9000", Partitions: []PartitionStat{{Mountpoint: "/mnt/shared"}, {Mountpoint: "/mnt/local2"}}},
+			{Addr: "node1:9000", Partitions: []PartitionStat{{Mountpoint: "/mnt/shared"}, {Mountpoint: "/mnt/local1"}, {Mountpoint: "/mnt/local1"}}},
+			{Addr: "node1:9000", Partitions: []PartitionStat{{Mountpoint: "/mnt/local1"}, {}}},
+			{Addr: "node3:9000", Partitions: []PartitionStat{{Mountpoint: "/mnt/shared"}, {}}},
+		},
+	}
+	want := map[string][]string{"/mnt/shared": {"node1:9000", "node2:9000", "node3:9000"}}
+	if got := info.DuplicateMountpoints(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSmartAtaRPM(t *testing.T) {
+	testCases := []struct {
+		rate string
+		rpm  int
+		ok   bool
+	}{
+		{rate: "7200 rpm", rpm: 7200, ok: true},
+		{rate: "5400 RPM", rpm: 5400, ok: true},
+		{rate: "10000", rpm: 10000, ok: true},
+		{rate: "Solid State Device", rpm: 0, ok: true},
+		{rate: "", rpm: 0, ok: false},
+		{rate: "unknown", rpm: 0, ok: false},
+		{rate: "-1 rpm", rpm: 0, ok: false},
+	}
+	for i, tc := range testCases {
+		rpm, ok := SmartAtaInfo{RotationRate: tc.rate}.RPM()
+		if rpm != tc.rpm || ok != tc.ok {
+			t.Fatalf("case %d: expected %d (%v), got %d (%v)", i+1, tc.rpm, tc.ok, rpm, ok)
+		}
+	}
+}
+
+func TestServerDiskHwInfoIsComplete(t *testing.T) {
+	usage := []*diskhw.UsageStat{{Path: "/mnt/disk1"}}
+	parts := []PartitionStat{{Device: "/dev/sda", Mountpoint: "/mnt/disk1"}}
+	testCases := []struct {
+		info ServerDiskHwInfo
+		want bool
+	}{
+		{info: ServerDiskHwInfo{Usage: usage, Partitions: parts}, want: true},
+		{info: ServerDiskHwInfo{Usage: usage, Partitions: parts, Error: "context canceled"}},
+		{info: ServerDiskHwInfo{Usage: usage}},
+		{info: ServerDiskHwInfo{Partitions: parts}},
+		{info: ServerDiskHwInfo{Usage: []*diskhw.UsageStat{nil}, Partitions: parts}},
+		{info: ServerDiskHwInfo{}},
+	}
+	for i, tc := range testCases {
+		if got := tc.info.IsComplete(); got != tc.want {
+			t.Fatalf("case %d: expected %v, got %v", i+1, tc.want, got)
+		}
+	}
+}
+
+func TestDeriveIOPS(t *testing.T) {
+	prev := ServerDiskHwInfo{Counters: map[string]diskhw.IOCountersStat{
+		"sda": {ReadCount: 100, WriteCount: 200},
+		"sdb": {ReadCount: 500, WriteCount: 500},
+		"sdc": {ReadCount: 1, WriteCount: 1},
+	}}
+	cur := ServerDiskHwInfo{Counters: map[string]diskhw.IOCountersStat{
+		"sda": {ReadCount: 400, WriteCount: 500},
+		"sdb": {ReadCount: 10, WriteCount: 10},
+		"sdd": {ReadCount: 10, WriteCount: 10},
+	}}
+	want := map[string]float64{"sda": 60}
+	if got := DeriveIOPS(prev, cur, 10*time.Second); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := DeriveIOPS(prev, cur, 0); got != nil {
+		t.Fatalf("expected no IOPS without interval, got %v", got)
+	}
+	if got := cur.IOPS(prev, 10*time.Second); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestServerProcInfoAnonymize(t *testing.T) {
+	info := ServerProcInfo{
+		Addr: "node1:9000",
+		Processes: []SysProcess{
+			{Pid: 1, Username: "minio-user", CmdLine: "minio server /data", Uids: []int32{1000}, Gids: []int32{1000}},
+			{Pid: 2, Username: "minio-user"},
+			{Pid: 3, Username: "root"},
+			{Pid: 4},
+		},
+	}
+	salt, err := NewAnonymizeSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	anon := info.AnonymizeWithSalt(salt)
+	if anon.Addr != info.Addr || len(anon.Processes) != 4 {
+		t.Fatalf("unexpected anonymized info %+v", anon)
+	}
+	p := anon.Processes
+	if p[0].Username == "minio-user" || p[0].Username != p[1].Username || p[0].Username == p[2].Username {
+		t.Fatalf("unexpected usernames %q, %q, %q", p[0].Username, p[1].Username, p[2].Username)
+	}
+	if p[3].Username != "" {
+		t.Fatalf("expected empty username to stay empty, got %q", p[3].Username)
+	}
+	if p[0].CmdLine != "" || p[0].Uids != nil || p[0].Gids != nil || p[0].Pid != 1 {
+		t.Fatalf("unexpected anonymized process %+v", p[0])
+	}
+	other := ServerProcInfo{Processes: []SysProcess{{Username: "minio-user"}}}
+	if anon := other.AnonymizeWithSalt(salt); anon.Processes[0].Username != p[0].Username {
+		t.Fatal("expected usernames to hash the same across nodes of a report")
+	}
+	otherSalt, err := NewAnonymizeSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if anon := other.AnonymizeWithSalt(otherSalt); anon.Processes[0].Username == p[0].Username {
+		t.Fatal("expected usernames to differ across reports")
+	}
+	unsalted := sha256.Sum256([]byte("minio-user"))
+	if p[0].Username == "user-"+hex.EncodeToString(unsalted[:6]) {
+		t.Fatal("expected usernames not to be plain hashes")
+	}
+
+	// Anonymize uses the same salt for all the nodes.
+	a, b := info.Anonymize(), other.Anonymize()
+	if a.Processes[0].Username != b.Processes[0].Username || a.Processes[0].Username == "minio-user" || a.Processes[0].CmdLine != "" {
+		t.Fatalf("unexpected anonymized processes %+v, %+v", a.Processes[0], b.Processes[0])
+	}
+	if info.Processes[0].Username != "minio-user" || info.Processes[0].CmdLine == "" {
+		t.Fatal("original process info was modified")
+	}
+}
+
+func TestDriveHealthSummary(t *testing.T) {
+	info := SysHealthInfo{
+		DiskHwInfo: []ServerDiskHwInfo{
+			{
+				Addr: "node1:9000",
+				Partitions: []PartitionStat{
+					{SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{CriticalWarning: "0", SpareAvailable: "100%", SpareThreshold: "10%"}}},
+					{SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{CriticalWarning: "1f"}}},
+					{SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{SpareAvailable: "5%", SpareThreshold: "10%"}}},
+					{SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{MediaAndDataIntegrityErrors: big.NewInt(2)}}},
+				},
+			},
+			{
+				Addr: "node2:9000",
+				Partitions: []PartitionStat{
+					{SmartInfo: SmartInfo{Ata: &SmartAtaInfo{ErrorLog: "No Errors Logged"}}},
+					{SmartInfo: SmartInfo{Ata: &SmartAtaInfo{ErrorLog: "ATA Error Count: 3"}}},
+					{SmartInfo: SmartInfo{Scsi: &SmartScsiInfo{}}},
+					{Device: "/dev/md0"},
+				},
+			},
+		},
+	}
+	want := map[DriveHealth]int{
+		DriveHealthy:       3,
+		DriveHealthWarning: 3,
+		DriveHealthFailing: 1,
+		DriveHealthUnknown: 1,
+	}
+	if got := info.DriveHealthSummary(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestHealthInfoV2TimestampIn(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	info := HealthInfoV2{TimeStamp: ts}
+	if got := info.TimestampIn(nil); got.Location() != time.UTC || got.Hour() != 10 {
+		t.Fatalf("expected UTC timestamp, got %v", got)
+	}
+	ny := time.FixedZone("EDT", -4*60*60)
+	if got := info.TimestampIn(ny); got.Location() != ny || got.Hour() != 6 || !got.Equal(ts) {
+		t.Fatalf("expected EDT timestamp, got %v", got)
+	}
+}
+
+func TestBuildLatencyMatrix(t *testing.T) {
+	nets := []NetPerfInfo{
+		{
+			NodeCommon: NodeCommon{Addr: "node1:9000"},
+			RemotePeers: []PeerNetPerfInfo{
+				{NodeCommon: NodeCommon{Addr: "node2:9000"}, Latency: Latency{Percentile99: 0.002}},
+				{NodeCommon: NodeCommon{Addr: "node3:9000", Error: "timeout"}},
+			},
+		},
+		{
+			NodeCommon:  NodeCommon{Addr: "node2:9000"},
+			RemotePeers: []PeerNetPerfInfo{{NodeCommon: NodeCommon{Addr: "node1:9000"}, Latency: Latency{Percentile99: 0.003}}},
+		},
+		{NodeCommon: NodeCommon{Addr: "node3:9000", Error: "offline"}},
+	}
+	want := map[string]map[string]float64{
+		"node1:9000": {"node2:9000": 0.002},
+		"node2:9000": {"node1:9000": 0.003},
+	}
+	if got := BuildLatencyMatrix(nets); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestPartitionStatSMARTEnabled(t *testing.T) {
+	testCases := []struct {
+		smart SmartInfo
+		want  bool
+	}{
+		{smart: SmartInfo{}, want: false},
+		{smart: SmartInfo{Nvme: &SmartNvmeInfo{}}, want: true},
+		{smart: SmartInfo{Scsi: &SmartScsiInfo{}}, want: true},
+		{smart: SmartInfo{Ata: &SmartAtaInfo{SmartSupportAvailable: true}}, want: false},
+		{smart: SmartInfo{Ata: &SmartAtaInfo{SmartSupportAvailable: true, SmartSupportEnabled: true}}, want: true},
+	}
+	for i, tc := range testCases {
+		if got := (PartitionStat{SmartInfo: tc.smart}).SMARTEnabled(); got != tc.want {
+			t.Fatalf("case %d: expected %v, got %v", i+1, tc.want, got)
+		}
+	}
+}
+
+func TestSortDrivePerfInfos(t *testing.T) {
+	drives := []DrivePerfInfo{{Path: "/mnt/drive3"}, {Path: "/mnt/drive1"}, {Path: "/mnt/drive2"}}
+	SortDrivePerfInfos(drives)
+	for i, d := range drives {
+		if want := "/mnt/drive" + strconv.Itoa(i+1); d.Path != want {
+			t.Fatalf("position %d: expected %s, got %s", i, want, d.Path)
+		}
+	}
+
+	nodes := []DrivePerfInfos{
+		{NodeCommon: NodeCommon{Addr: "node2:9000"}},
+		{NodeCommon: NodeCommon{Addr: "node1:9000"}},
+	}
+	SortDrivePerfNodes(nodes)
+	if nodes[0].Addr != "node1:9000" || nodes[1].Addr != "node2:9000" {
+		t.Fatalf("unexpected node order %s, %s", nodes[0].Addr, nodes[1].Addr)
+	}
+}
+
+func TestPerfInfoLatencyBounds(t *testing.T) {
+	if _, _, ok := (PerfInfo{}).LatencyBounds(); ok {
+		t.Fatal("expected no bounds without drives")
+	}
+	perf := PerfInfo{Drives: []DrivePerfInfos{
+		{
+			NodeCommon: NodeCommon{Addr: "node1:9000"},
+			SerialPerf: []DrivePerfInfo{
+				{Path: "/mnt/drive1", Latency: Latency{Percentile99: 0.004}},
+				{Path: "/mnt/drive2", Error: "faulty", Latency: Latency{Percentile99: 9}},
+			},
+			ParallelPerf: []DrivePerfInfo{{Path: "/mnt/drive1", Latency: Latency{Percentile99: 0.010}}},
+		},
+		{
+			NodeCommon: NodeCommon{Addr: "node2:9000"},
+			SerialPerf: []DrivePerfInfo{{Path: "/mnt/drive1", Latency: Latency{Percentile99: 0.002}}},
+		},
+		{
+			NodeCommon: NodeCommon{Addr: "node3:9000", Error: "offline"},
+			SerialPerf: []DrivePerfInfo{{Path: "/mnt/drive1", Latency: Latency{Percentile99: 0.001}}},
+		},
+	}}
+	min, max, ok := perf.LatencyBounds()
+	if !ok || min != 0.002 || max != 0.010 {
+		t.Fatalf("expected bounds 0.002, 0.010, got %v, %v (ok=%v)", min, max, ok)
+	}
+}
+
+func TestPerfInfoAge(t *testing.T) {
+	if age := (PerfInfo{}).Age(); age != 0 {
+		t.Fatalf("expected no age without collection time, got %v", age)
+	}
+	at := time.Now().Add(-time.Hour)
+	perf := PerfInfo{CollectedAt: &at}
+	if age := perf.Age(); age < time.Hour || age > 2*time.Hour {
+		t.Fatalf("expected an age of about an hour, got %v", age)
+	}
+}
+
+func TestNetPerfInfoExternalPeers(t *testing.T) {
+	testCases := []struct {
+		addr  string
+		peers []string
+		want  []string
+	}{
+		{addr: "node1:9000", peers: []string{"node1:9000", "node2:9000"}, want: []string{"node2:9000"}},
+		{addr: "http://node1:9000", peers: []string{"node2:9000", "node1:9000"}, want: []string{"node2:9000"}},
+		// Nodes sharing a host on other ports are real peers.
+		{addr: "node1:9000", peers: []string{"node1:9001", "node1:9000"}, want: []string{"node1:9001"}},
+		{addr: "localhost:9000", peers: []string{"localhost:9001", "localhost:9002"}, want: []string{"localhost:9001", "localhost:9002"}},
+		{addr: "node1:9000", peers: []string{"node1", "node2:9000"}, want: []string{"node2:9000"}},
+		{addr: "node1:9000", peers: []string{"node2:9000"}, want: []string{"node2:9000"}},
+	}
+	for i, tc := range testCases {
+		n := NetPerfInfo{NodeCommon: NodeCommon{Addr: tc.addr}}
+		for _, p := range tc.peers {
+			n.RemotePeers = append(n.RemotePeers, PeerNetPerfInfo{NodeCommon: NodeCommon{Addr: p}})
+		}
+		var got []string
+		for _, p := range n.ExternalPeers() {
+			got = append(got, p.Addr)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("case %d: expected %v, got %v", i+1, tc.want, got)
+		}
+	}
+}
+
+func TestServerDiskHwInfoUsedPercent(t *testing.T) {
+	if p := (ServerDiskHwInfo{}).UsedPercent(); p != 0 {
+		t.Fatalf("expected 0 without usage, got %v", p)
+	}
+	s := ServerDiskHwInfo{Usage: []*diskhw.UsageStat{
+		{Total: 100, Used: 20},
+		nil,
+		{Total: 300, Used: 80},
+	}}
+	if p := s.UsedPercent(); p != 25 {
+		t.Fatalf("expected 25, got %v", p)
+	}
+}
+
+func TestSmartNvmeInfoMediaErrors(t *testing.T) {
+	huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+	testCases := []struct {
+		errs      *big.Int
+		hasErrors bool
+		count     int64
+		ok        bool
+	}{
+		{errs: nil},
+		{errs: big.NewInt(0), ok: true},
+		{errs: big.NewInt(3), hasErrors: true, count: 3, ok: true},
+		{errs: huge, hasErrors: true, count: math.MaxInt64, ok: true},
+	}
+	for i, tc := range testCases {
+		s := SmartNvmeInfo{MediaAndDataIntegrityErrors: tc.errs}
+		if got := s.HasMediaErrors(); got != tc.hasErrors {
+			t.Fatalf("case %d: expected HasMediaErrors %v, got %v", i+1, tc.hasErrors, got)
+		}
+		if count, ok := s.MediaErrorCount(); count != tc.count || ok != tc.ok {
+			t.Fatalf("case %d: expected count %d (ok=%v), got %d (ok=%v)", i+1, tc.count, tc.ok, count, ok)
+		}
+	}
+}
+
+func TestSysHealthInfoTotalDataWritten(t *testing.T) {
+	if total := (SysHealthInfo{}).TotalDataWritten(); total.Sign() != 0 {
+		t.Fatalf("expected zero without drives, got %v", total)
+	}
+	nvme := func(serial string, written int64) SmartInfo {
+		return SmartInfo{Nvme: &SmartNvmeInfo{SerialNum: serial, DataUnitsWrittenBytes: big.NewInt(written)}}
+	}
+	s := SysHealthInfo{DiskHwInfo: []ServerDiskHwInfo{
+		{
+			Addr: "node1:9000",
+			Partitions: []PartitionStat{
+				{Device: "/dev/nvme0n1p1", SmartInfo: nvme("S1", 1000)},
+				// Second partition of the same drive.
+				{Device: "/dev/nvme0n1p2", SmartInfo: nvme("S1", 1000)},
+				{Device: "/dev/nvme1n1", SmartInfo: nvme("S2", 500)},
+				{Device: "/dev/nvme2n1", SmartInfo: SmartInfo{Nvme: &SmartNvmeInfo{SerialNum: "S3"}}},
+				{Device: "/dev/sda", SmartInfo: SmartInfo{Ata: &SmartAtaInfo{}}},
+			},
+		},
+		{
+			Addr:       "node2:9000",
+			Partitions: []PartitionStat{{Device: "/dev/nvme0n1", SmartInfo: nvme("S1", 250)}},
+		},
+	}}
+	if total := s.TotalDataWritten(); total.Cmp(big.NewInt(1750)) != 0 {
+		t.Fatalf("expected 1750, got %v", total)
+	}
+}
+
+func TestServerProcInfoHighFDProcesses(t *testing.T) {
+	s := ServerProcInfo{Processes: []SysProcess{
+		{Pid: 1, NumFds: 10},
+		{Pid: 2, NumFds: 5000},
+		{Pid: 3},
+		{Pid: 4, NumFds: 1000},
+	}}
+	var pids []int32
+	for _, p := range s.HighFDProcesses(1000) {
+		pids = append(pids, p.Pid)
+	}
+	if !reflect.DeepEqual(pids, []int32{2}) {
+		t.Fatalf("expected pid 2, got %v", pids)
+	}
+	if procs := s.HighFDProcesses(-1); len(procs) != 3 {
+		t.Fatalf("expected processes with unknown descriptors to be skipped, got %d", len(procs))
+	}
+}
+
+func TestSysHealthInfoDegradedCapacity(t *testing.T) {
+	info := SysHealthInfo{DiskHwInfo: []ServerDiskHwInfo{{
+		Addr:  "node1:9000",
+		Usage: []*diskhw.UsageStat{{Path: "/mnt/drive1", Total: 1000, Free: 80, Used: 920}},
+	}}}
+	testCases := []struct {
+		threshold float64
+		want      bool
+	}{
+		{threshold: 0.1, want: true},
+		{threshold: 0.08, want: false},
+		{threshold: 0.05, want: false},
+	}
+	for _, tc := range testCases {
+		if got := info.DegradedCapacity(tc.threshold); got != tc.want {
+			t.Fatalf("threshold %v: expected %v, got %v", tc.threshold, tc.want, got)
+		}
+	}
+	if (SysHealthInfo{}).DegradedCapacity(0.1) {
+		t.Fatal("expected unknown capacity not to be degraded")
+	}
+}
+
+func TestHealthInfoV2Summary(t *testing.T) {
+	const tib = 1 << 40
+	info := HealthInfoV2{Sys: SysInfo{
+		Partitions: []Partitions{
+			{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				Partitions: []Partition{
+					{Device: "/dev/sda", SpaceTotal: 100 * tib, SpaceFree: 60 * tib},
+					{Device: "/dev/sdb", SpaceTotal: 100 * tib, SpaceFree: 1 * tib},
+				},
+			},
+			{
+				NodeCommon: NodeCommon{Addr: "node2:9000"},
+				Partitions: []Partition{
+					{Device: "/dev/sda", SpaceTotal: 200 * tib, SpaceFree: 119 * tib},
+					{Device: "/dev/sdb", Error: "faulty"},
+				},
+			},
+		},
+		ProcInfo: []ProcInfo{
+			{NodeCommon: NodeCommon{Addr: "node1:9000"}, CPUPercent: 40},
+			{NodeCommon: NodeCommon{Addr: "node2:9000"}, CPUPercent: 44},
+			{NodeCommon: NodeCommon{Addr: "node3:9000", Error: "offline"}},
+		},
+	}}
+	want := "3 nodes, 4 drives (2 warning), 220 TiB used / 400 TiB, MinIO process CPU avg 42%"
+	if got := info.Summary(); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+	want = "0 nodes, n/a drives, n/a used, MinIO process CPU avg n/a"
+	if got := (HealthInfoV2{}).Summary(); got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestSysHealthInfoClockSkew(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	hostTime := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+	info := SysHealthInfo{
+		OsInfo: []ServerOsInfo{
+			{Addr: "node1:9000", HostTime: hostTime(2 * time.Second)},
+			{Addr: "node2:9000", HostTime: hostTime(-time.Minute)},
+			{Addr: "node3:9000"},
+			{Addr: "node4:9000", HostTime: hostTime(0), Error: "offline"},
+		},
+	}
+	want := map[string]time.Duration{"node1:9000": 2 * time.Second, "node2:9000": -time.Minute}
+	if got := info.ClockSkew(now); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	b, err := json.Marshal(info.OsInfo[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "hostTime") {
+		t.Fatalf("expected no host time when not reported, got %s", b)
+	}
+}
+
+func TestHealthInfoV0ClockSkew(t *testing.T) {
+	report := `{
+ "timestamp": "2024-01-02T03:04:05Z",
+ "sys": {
+  "osinfos": [
+   {"addr": "node1:9000", "info": {"hostname": "node1"}, "hostTime": "2024-01-02T03:04:07Z"},
+   {"addr": "node2:9000", "info": {"hostname": "node2"}, "hostTime": "2024-01-02T05:04:05+02:00"},
+   {"addr": "node3:9000", "error": "timeout"}
+  ]
+ }
+}`
+	var info HealthInfoV0
+	if err := json.Unmarshal([]byte(report), &info); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]time.Duration{"node1:9000": 2 * time.Second, "node2:9000": 0}
+	if got := info.ClockSkew(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestServerDiskHwInfoPartitionsByFS(t *testing.T) {
+	s := ServerDiskHwInfo{Partitions: []PartitionStat{
+		{Device: "/dev/sda", Fstype: "xfs"},
+		{Device: "/dev/sdb", Fstype: "ext4"},
+		{Device: "/dev/sdc", Fstype: "XFS"},
+	}}
+	var devices []string
+	for _, p := range s.PartitionsByFS("Xfs") {
+		devices = append(devices, p.Device)
+	}
+	if want := []string{"/dev/sda", "/dev/sdc"}; !reflect.DeepEqual(devices, want) {
+		t.Fatalf("expected %v, got %v", want, devices)
+	}
+	if parts := s.PartitionsByFS("zfs"); len(parts) != 0 {
+		t.Fatalf("expected no zfs partitions, got %v", parts)
+	}
+}
+
+func TestParseProcessState(t *testing.T) {
+	testCases := []struct {
+		status string
+		want   ProcessState
+	}{
+		{status: "R", want: ProcessStateRunning},
+		{status: "S", want: ProcessStateSleeping},
+		{status: "D", want: ProcessStateBlocked},
+		{status: "t", want: ProcessStateStopped},
+		{status: "Z", want: ProcessStateZombie},
+		{status: "zombie", want: ProcessStateZombie},
+		{status: "Sleep", want: ProcessStateSleeping},
+		{status: "running,lock", want: ProcessStateRunning},
+		{status: "X", want: ProcessStateUnknown},
+		{status: "", want: ProcessStateUnknown},
+	}
+	for _, tc := range testCases {
+		if got := ParseProcessState(tc.status); got != tc.want {
+			t.Fatalf("status %q: expected %s, got %s", tc.status, tc.want, got)
+		}
+	}
+	if !(SysProcess{Status: "Z"}).IsZombie() || (SysProcess{Status: "R"}).IsZombie() {
+		t.Fatal("unexpected zombie detection")
+	}
+}
+
+func TestPerfInfoEncode(t *testing.T) {
+	perf := benchmarkPerfInfo(2, 4)
+	var buf bytes.Buffer
+	if err := perf.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want, err := json.Marshal(perf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bytes.TrimSuffix(buf.Bytes(), []byte("\n")); !bytes.Equal(got, want) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func benchmarkPerfInfo(nodes, drives int) PerfInfo {
+	var perf PerfInfo
+	for n := 0; n < nodes; n++ {
+		addr := "node" + strconv.Itoa(n) + ":9000"
+		d := DrivePerfInfos{NodeCommon: NodeCommon{Addr: addr}}
+		for i := 0; i < drives; i++ {
+			d.SerialPerf = append(d.SerialPerf, DrivePerfInfo{
+				Path:       "/mnt/drive" + strconv.Itoa(i),
+				Latency:    Latency{Avg: 0.002, Max: 0.01, Min: 0.001, Percentile50: 0.002, Percentile90: 0.004, Percentile99: 0.008},
+				Throughput: Throughput{Avg: 1 << 30, Max: 2 << 30, Min: 1 << 29, Percentile50: 1 << 30, Percentile90: 3 << 29, Percentile99: 2 << 30},
+			})
+		}
+		perf.Drives = append(perf.Drives, d)
+		net := NetPerfInfo{NodeCommon: NodeCommon{Addr: addr}}
+		for p := 0; p < nodes; p++ {
+			net.RemotePeers = append(net.RemotePeers, PeerNetPerfInfo{NodeCommon: NodeCommon{Addr: "node" + strconv.Itoa(p) + ":9000"}})
+		}
+		perf.Net = append(perf.Net, net)
+	}
+	return perf
+}
+
+func BenchmarkPerfInfoEncode(b *testing.B) {
+	perf := benchmarkPerfInfo(32, 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := perf.Encode(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPerfInfoMarshalIndent(b *testing.B) {
+	perf := benchmarkPerfInfo(32, 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		data, err := json.MarshalIndent(perf, " ", "    ")
+		if err != nil {
+			b.Fatal(err)
+		}
+		io.Discard.Write(data)
+	}
+}
+
+func TestSysProcessArgs(t *testing.T) {
+	testCases := []struct {
+		cmdLine string
+		want    []string
+	}{
+		{cmdLine: "", want: []string{}},
+		{cmdLine: "minio server  /mnt/drive{1...4} ", want: []string{"minio", "server", "/mnt/drive{1...4}"}},
+		{cmdLine: "minio\x00server\x00--address\x00:9000 \x00\x00", want: []string{"minio", "server", "--address", ":9000"}},
+		{cmdLine: "minio\x00server\x00/mnt/my drive", want: []string{"minio", "server", "/mnt/my drive"}},
+	}
+	for _, tc := range testCases {
+		got := SysProcess{CmdLine: tc.cmdLine}.Args()
+		if got == nil || !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("cmdline %q: expected %q, got %q", tc.cmdLine, tc.want, got)
+		}
+	}
+}
+
+func TestHealthReport(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, report := range []HealthReport{
+		HealthInfoV0{TimeStamp: now, Error: "drive offline"},
+		HealthInfoV2{Version: HealthInfoVersion2, TimeStamp: now, Error: "drive offline"},
+	} {
+		if report.GetError() != "drive offline" || !report.GetTimestamp().Equal(now) {
+			t.Fatalf("%T: unexpected error %q or timestamp %v", report, report.GetError(), report.GetTimestamp())
+		}
+		if !strings.Contains(report.JSON(), `"drive offline"`) {
+			t.Fatalf("%T: error missing from JSON", report)
+		}
+	}
+}
+
+func TestHealthInfoV0GetStatus(t *testing.T) {
+	if status := (HealthInfoV0{}).GetStatus(); status != "success" {
+		t.Fatalf("expected success, got %s", status)
+	}
+	if status := (HealthInfoV0{Error: "failed"}).GetStatus(); status != "error" {
+		t.Fatalf("expected error, got %s", status)
+	}
+}
+
+func TestServerCPUInfoPhysicalCPUs(t *testing.T) {
+	var s ServerCPUInfo
+	for i := 0; i < 8; i++ {
+		s.CPUStat = append(s.CPUStat, cpu.InfoStat{
+			CPU:        int32(i),
+			PhysicalID: strconv.Itoa(i / 4),
+			CoreID:     strconv.Itoa(i % 4),
+			ModelName:  "Intel(R) Xeon(R)",
+			Cores:      1,
+		})
+	}
+	cpus := s.PhysicalCPUs()
+	if len(cpus) != 2 {
+		t.Fatalf("expected 2 physical CPUs, got %d", len(cpus))
+	}
+	for i, c := range cpus {
+		if c.PhysicalID != strconv.Itoa(i) || c.Cores != 4 {
+			t.Fatalf("CPU %d: unexpected physical id %s or cores %d", i, c.PhysicalID, c.Cores)
+		}
+	}
+}
+
+func TestSmartInfoFailureScore(t *testing.T) {
+	testCases := []struct {
+		name  string
+		smart SmartInfo
+		want  int
+	}{
+		{name: "no smart data", smart: SmartInfo{}, want: 0},
+		{name: "ata", smart: SmartInfo{Ata: &SmartAtaInfo{}}, want: 0},
+		{name: "healthy", smart: SmartInfo{Nvme: &SmartNvmeInfo{SpareAvailable: "100%", Temperature: "40 C"}}, want: 0},
+		{name: "media errors", smart: SmartInfo{Nvme: &SmartNvmeInfo{MediaAndDataIntegrityErrors: big.NewInt(1)}}, want: 40},
+		{name: "half spare used", smart: SmartInfo{Nvme: &SmartNvmeInfo{SpareAvailable: "50%", SpareThreshold: "10%"}}, want: 15},
+		{name: "spare at threshold", smart: SmartInfo{Nvme: &SmartNvmeInfo{SpareAvailable: "10%", SpareThreshold: "10%"}}, want: 30},
+		{name: "warm", smart: SmartInfo{Nvme: &SmartNvmeInfo{Temperature: "60 C"}}, want: 8},
+		{name: "hot", smart: SmartInfo{Nvme: &SmartNvmeInfo{Temperature: "90 C"}}, want: 15},
+		{name: "unsafe shutdowns", smart: SmartInfo{Nvme: &SmartNvmeInfo{UnsafeShutdowns: big.NewInt(42)}}, want: 4},
+		{name: "all signals", smart: SmartInfo{Nvme: &SmartNvmeInfo{
+			MediaAndDataIntegrityErrors: big.NewInt(7),
+			SpareAvailable:              "5%",
+			Temperature:                 "75 C",
+			UnsafeShutdowns:             big.NewInt(1000),
+		}}, want: 100},
+	}
+	for _, tc := range testCases {
+		if got := tc.smart.FailureScore(); got != tc.want {
+			t.Fatalf("%s: expected %d, got %d", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestSysHealthInfoDrivePaths(t *testing.T) {
+	s := SysHealthInfo{DiskHwInfo: []ServerDiskHwInfo{
+		{
+			Addr: "node2:9000",
+			Partitions: []PartitionStat{
+				{Device: "/dev/sdb", Mountpoint: "/mnt/drive2"},
+				{Device: "/dev/sda", Mountpoint: "/mnt/drive1"},
+				{Device: "/dev/sdc"},
+			},
+		},
+		{
+			Addr: "node1:9000",
+			Partitions: []PartitionStat{
+				{Device: "/dev/sda", Mountpoint: "/mnt/drive1"},
+				{Device: "/dev/sda", Mountpoint: "/mnt/drive1"},
+			},
+		},
+	}}
+	want := []string{"node1:9000:/mnt/drive1", "node2:9000:/dev/sdc", "node2:9000:/mnt/drive1", "node2:9000:/mnt/drive2"}
+	if got := s.DrivePaths(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSmartNvmeInfoCriticalWarnings(t *testing.T) {
+	testCases := []struct {
+		warning string
+		want    []string
+	}{
+		{warning: "", want: []string{}},
+		{warning: "invalid", want: []string{}},
+		{warning: "0", want: []string{}},
+		{warning: "0x1", want: []string{"available spare below threshold"}},
+		{warning: "12", want: []string{"temperature outside of threshold", "volatile memory backup failed"}},
+		{warning: "0x14", want: []string{"reliability degraded", "volatile memory backup failed"}},
+		{warning: "0xc0", want: []string{}},
+		{warning: "a", want: []string{"temperature outside of threshold", "media in read-only mode"}},
+		{warning: "10", want: []string{"volatile memory backup failed"}},
+		{warning: "010", want: []string{"volatile memory backup failed"}},
+		{warning: "1f", want: []string{"available spare below threshold", "temperature outside of threshold", "reliability degraded", "media in read-only mode", "volatile memory backup failed"}},
+	}
+	for _, tc := range testCases {
+		got := SmartNvmeInfo{CriticalWarning: tc.warning}.CriticalWarnings()
+		if got == nil || !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("warning %q: expected %q, got %q", tc.warning, tc.want, got)
+		}
+	}
+}
+
+func TestPerfInfoTotalNetThroughput(t *testing.T) {
+	perf := PerfInfo{
+		Net: []NetPerfInfo{{
+			NodeCommon:  NodeCommon{Addr: "node1:9000"},
+			RemotePeers: []PeerNetPerfInfo{{NodeCommon: NodeCommon{Addr: "node2:9000"}, Throughput: Throughput{Avg: 1 << 40}}},
+		}},
+		NetParallel: NetPerfInfo{
+			NodeCommon: NodeCommon{Addr: "node1:9000"},
+			RemotePeers: []PeerNetPerfInfo{
+				{NodeCommon: NodeCommon{Addr: "node1:9000"}, Throughput: Throughput{Avg: 100}},
+				{NodeCommon: NodeCommon{Addr: "node2:9000"}, Throughput: Throughput{Avg: 200}},
+				{NodeCommon: NodeCommon{Addr: "node3:9000", Error: "timeout"}, Throughput: Throughput{Avg: 1000}},
+			},
+		},
+	}
+	if total := perf.TotalNetThroughput(); total != 300 {
+		t.Fatalf("expected 300, got %d", total)
+	}
+}
@@ -0,0 +1,149 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// promFamily is a gauge metric family in the Prometheus text format.
+type promFamily struct {
+	name, help string
+	samples    []promSample
+}
+
+type promSample struct {
+	labels []string // label name and value pairs
+	value  float64
+}
+
+func (f *promFamily) add(value float64, labels ...string) {
+	f.samples = append(f.samples, promSample{labels: labels, value: value})
+}
+
+// promEscaper escapes label values in the Prometheus text format.
+var promEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func (f *promFamily) writeTo(w *bufio.Writer) {
+	if len(f.samples) == 0 {
+		return
+	}
+	w.WriteString("# HELP " + f.name + " " + f.help + "\n")
+	w.WriteString("# TYPE " + f.name + " gauge\n")
+	for _, s := range f.samples {
+		w.WriteString(f.name)
+		for i := 0; i+1 < len(s.labels); i += 2 {
+			if i == 0 {
+				w.WriteByte('{')
+			} else {
+				w.WriteByte(',')
+			}
+			w.WriteString(s.labels[i] + `="` + promEscaper.Replace(s.labels[i+1]) + `"`)
+		}
+		if len(s.labels) > 1 {
+			w.WriteByte('}')
+		}
+		w.WriteString(" " + strconv.FormatFloat(s.value, 'g', -1, 64) + "\n")
+	}
+}
+
+// WritePrometheus writes the drive capacity, CPU, memory and drive latency
+// of each node in the Prometheus text exposition format. All metrics are
+// gauges named minio_health_*, labeled with the node address. Entries
+// which failed to be collected are skipped.
+func (info HealthInfoV2) WritePrometheus(w io.Writer) error {
+	var (
+		driveTotal = promFamily{name: "minio_health_drive_total_bytes", help: "Total capacity of the drive in bytes."}
+		driveFree  = promFamily{name: "minio_health_drive_free_bytes", help: "Free capacity of the drive in bytes."}
+		cpuCores   = promFamily{name: "minio_health_cpu_cores", help: "Number of CPU cores of the node."}
+		procCPU    = promFamily{name: "minio_health_process_cpu_percent", help: "CPU usage of the MinIO process in percent."}
+		memTotal   = promFamily{name: "minio_health_memory_total_bytes", help: "Total memory of the node in bytes."}
+		memUsed    = promFamily{name: "minio_health_memory_used_bytes", help: "Used memory of the node in bytes."}
+		memAvail   = promFamily{name: "minio_health_memory_available_bytes", help: "Available memory of the node in bytes."}
+		latency    = promFamily{name: "minio_health_drive_latency_seconds", help: "Write latency of the drive in seconds by quantile."}
+	)
+
+	for _, parts := range info.Sys.Partitions {
+		if parts.Error != "" {
+			continue
+		}
+		for _, p := range parts.Partitions {
+			if p.Error != "" {
+				continue
+			}
+			driveTotal.add(float64(p.SpaceTotal), "node", parts.Addr, "device", p.Device, "mountpoint", p.Mountpoint)
+			driveFree.add(float64(p.SpaceFree), "node", parts.Addr, "device", p.Device, "mountpoint", p.Mountpoint)
+		}
+	}
+	for _, cpus := range info.Sys.CPUInfo {
+		if cpus.Error != "" {
+			continue
+		}
+		cores := 0
+		for _, c := range cpus.CPUs {
+			cores += c.Cores
+		}
+		cpuCores.add(float64(cores), "node", cpus.Addr)
+	}
+	for _, p := range info.Sys.ProcInfo {
+		if p.Error != "" {
+			continue
+		}
+		procCPU.add(p.CPUPercent, "node", p.Addr, "pid", strconv.Itoa(int(p.PID)))
+	}
+	for _, m := range info.Sys.MemInfo {
+		if m.Error != "" {
+			continue
+		}
+		memTotal.add(float64(m.Total), "node", m.Addr)
+		memUsed.add(float64(m.Used), "node", m.Addr)
+		memAvail.add(float64(m.Available), "node", m.Addr)
+	}
+	for _, node := range info.Perf.Drives {
+		if node.Error != "" {
+			continue
+		}
+		for _, mode := range []struct {
+			name  string
+			perfs []DrivePerfInfo
+		}{{"serial", node.SerialPerf}, {"parallel", node.ParallelPerf}} {
+			for _, d := range mode.perfs {
+				if d.Error != "" {
+					continue
+				}
+				for _, q := range []struct {
+					quantile string
+					value    float64
+				}{{"0.5", d.Latency.Percentile50}, {"0.9", d.Latency.Percentile90}, {"0.99", d.Latency.Percentile99}} {
+					latency.add(q.value, "node", node.Addr, "path", d.Path, "mode", mode.name, "quantile", q.quantile)
+				}
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, f := range []*promFamily{&driveTotal, &driveFree, &cpuCores, &procCPU, &memTotal, &memUsed, &memAvail, &latency} {
+		f.writeTo(bw)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHealthInfoV2WritePrometheus(t *testing.T) {
+	info := HealthInfoV2{
+		Sys: SysInfo{
+			Partitions: []Partitions{
+				{
+					NodeCommon: NodeCommon{Addr: "node1:9000"},
+					Partitions: []Partition{
+						{Device: "/dev/sda", Mountpoint: "/mnt/drive1", SpaceTotal: 1000, SpaceFree: 400},
+						{Device: "/dev/sdb", Error: "not mounted"},
+					},
+				},
+				{NodeCommon: NodeCommon{Addr: "node2:9000", Error: "timeout"}},
+			},
+			CPUInfo:  []CPUs{{NodeCommon: NodeCommon{Addr: "node1:9000"}, CPUs: []CPU{{Cores: 8}, {Cores: 8}}}},
+			MemInfo:  []MemInfo{{NodeCommon: NodeCommon{Addr: "node1:9000"}, Total: 64, Used: 16, Available: 40}},
+			ProcInfo: []ProcInfo{{NodeCommon: NodeCommon{Addr: "node1:9000"}, PID: 42, CPUPercent: 12.5}},
+		},
+		Perf: PerfInfo{
+			Drives: []DrivePerfInfos{{
+				NodeCommon: NodeCommon{Addr: `node"1`},
+				SerialPerf: []DrivePerfInfo{{Path: "/mnt/drive1", Latency: Latency{Percentile50: 0.001, Percentile90: 0.002, Percentile99: 0.004}}},
+			}},
+		},
+	}
+
+	var buf strings.Builder
+	if err := info.WritePrometheus(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"# HELP minio_health_drive_total_bytes Total capacity of the drive in bytes.\n# TYPE minio_health_drive_total_bytes gauge\n",
+		`minio_health_drive_total_bytes{node="node1:9000",device="/dev/sda",mountpoint="/mnt/drive1"} 1000`,
+		`minio_health_drive_free_bytes{node="node1:9000",device="/dev/sda",mountpoint="/mnt/drive1"} 400`,
+		`minio_health_cpu_cores{node="node1:9000"} 16`,
+		`minio_health_process_cpu_percent{node="node1:9000",pid="42"} 12.5`,
+		`minio_health_memory_available_bytes{node="node1:9000"} 40`,
+		`minio_health_drive_latency_seconds{node="node\"1",path="/mnt/drive1",mode="serial",quantile="0.99"} 0.004`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q", want)
+		}
+	}
+	if strings.Contains(out, "/dev/sdb") || strings.Contains(out, "node2") {
+		t.Errorf("output contains failed entries:\n%s", out)
+	}
+
+	// The output must be parseable as Prometheus text.
+	families, err := ParsePrometheusResults(strings.NewReader(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(families) != 8 {
+		t.Fatalf("expected 8 metric families, got %d", len(families))
+	}
+}
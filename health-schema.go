@@ -0,0 +1,216 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"encoding"
+	"encoding/json"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// HealthInfoV2Schema returns a JSON Schema document describing the JSON
+// encoding of HealthInfoV2. It is derived from the struct definitions, so
+// it always matches the health info sent by this version of the package.
+// Fields always written by encoding/json are listed as required.
+func HealthInfoV2Schema() []byte {
+	g := schemaGenerator{defs: map[string]interface{}{}}
+	schema := map[string]interface{}{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "HealthInfoV2",
+		"$ref":    g.schemaFor(reflect.TypeOf(HealthInfoV2{}))["$ref"],
+		"$defs":   g.defs,
+	}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		panic(err) // This never happens.
+	}
+	return data
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schemaGenerator builds JSON Schemas of Go types, collecting
+// the schemas of named structs as definitions.
+type schemaGenerator struct {
+	defs map[string]interface{}
+}
+
+var madminPkgPath = reflect.TypeOf(HealthInfoV2{}).PkgPath()
+
+// schemaDefName returns the definition name of a named type.
+func schemaDefName(t reflect.Type) string {
+	pkg := path.Base(t.PkgPath())
+	if t.PkgPath() == madminPkgPath {
+		pkg = "madmin"
+	}
+	return pkg + "." + t.Name()
+}
+
+// schemaIsOpaque returns true if t has a custom JSON encoding
+// which can't be derived from its definition.
+func schemaIsOpaque(t reflect.Type) bool {
+	if t == timeType {
+		return false
+	}
+	return t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType)
+}
+
+func (g *schemaGenerator) schemaFor(t reflect.Type) map[string]interface{} {
+	// Pointers are encoded as null or as the value they point to,
+	// whichever of them implements a custom encoding.
+	if t.Kind() == reflect.Pointer {
+		return nullable(g.schemaFor(t.Elem()))
+	}
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	if schemaIsOpaque(t) {
+		return map[string]interface{}{}
+	}
+	if t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType) {
+		return map[string]interface{}{"type": "string"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": []string{"string", "null"}, "contentEncoding": "base64"}
+		}
+		return map[string]interface{}{"type": []string{"array", "null"}, "items": g.schemaFor(t.Elem())}
+	case reflect.Array:
+		return map[string]interface{}{"type": "array", "items": g.schemaFor(t.Elem()), "maxItems": t.Len(), "minItems": t.Len()}
+	case reflect.Map:
+		return map[string]interface{}{"type": []string{"object", "null"}, "additionalProperties": g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		name := schemaDefName(t)
+		if _, ok := g.defs[name]; !ok {
+			g.defs[name] = nil // Reserve the name for recursive types.
+			g.defs[name] = g.structSchema(t)
+		}
+		return map[string]interface{}{"$ref": "#/$defs/" + name}
+	}
+	// Interfaces may hold any value.
+	return map[string]interface{}{}
+}
+
+// nullable returns a schema which also accepts null.
+func nullable(s map[string]interface{}) map[string]interface{} {
+	if len(s) == 0 {
+		return s
+	}
+	return map[string]interface{}{"anyOf": []interface{}{s, map[string]interface{}{"type": "null"}}}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]interface{} {
+	props := map[string]interface{}{}
+	required := map[string]bool{}
+	g.addFields(t, props, required)
+	schema := map[string]interface{}{"type": "object", "properties": props}
+	if len(required) > 0 {
+		names := make([]string, 0, len(required))
+		for name := range required {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		schema["required"] = names
+	}
+	return schema
+}
+
+// schemaAlwaysEncoded returns true if encoding/json always writes a field
+// of type t with the options opts of its tag.
+func schemaAlwaysEncoded(t reflect.Type, opts string) bool {
+	if !strings.Contains(","+opts+",", ",omitempty,") {
+		return true
+	}
+	// omitempty doesn't apply to structs.
+	return t.Kind() == reflect.Struct
+}
+
+// addFields adds the JSON fields of struct t to props, flattening
+// embedded structs the same way encoding/json does. The names of the
+// fields always present in the encoding are added to required.
+func (g *schemaGenerator) addFields(t reflect.Type, props map[string]interface{}, required map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			ptr := ft.Kind() == reflect.Pointer
+			if ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && !schemaIsOpaque(ft) {
+				// Fields of the outer struct take precedence.
+				embedded := map[string]interface{}{}
+				embeddedRequired := map[string]bool{}
+				g.addFields(ft, embedded, embeddedRequired)
+				for k, v := range embedded {
+					if _, ok := props[k]; !ok {
+						props[k] = v
+						// Fields of nil embedded pointers are omitted.
+						if embeddedRequired[k] && !ptr {
+							required[k] = true
+						}
+					}
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if schemaAlwaysEncoded(ft, opts) {
+			required[name] = true
+		} else {
+			delete(required, name)
+		}
+		if strings.Contains(","+opts+",", ",string,") {
+			props[name] = map[string]interface{}{"type": "string"}
+			continue
+		}
+		props[name] = g.schemaFor(ft)
+	}
+}
@@ -0,0 +1,200 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// jsonFields returns the JSON field names of struct t with their types,
+// including the fields promoted from embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && !schemaIsOpaque(ft) {
+				for k, v := range jsonFields(ft) {
+					if _, ok := fields[k]; !ok {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+func TestHealthInfoV2Schema(t *testing.T) {
+	var schema struct {
+		Ref  string                     `json:"$ref"`
+		Defs map[string]json.RawMessage `json:"$defs"`
+	}
+	if err := json.Unmarshal(HealthInfoV2Schema(), &schema); err != nil {
+		t.Fatal(err)
+	}
+	if schema.Ref != "#/$defs/madmin.HealthInfoV2" {
+		t.Fatalf("unexpected root reference %q", schema.Ref)
+	}
+
+	type objectSchema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+
+	// Every JSON field of every named struct reachable from
+	// HealthInfoV2 must be described by the schema.
+	seen := map[reflect.Type]bool{}
+	var check func(rt reflect.Type, props map[string]json.RawMessage)
+	check = func(rt reflect.Type, props map[string]json.RawMessage) {
+		for rt.Kind() == reflect.Pointer || rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array || rt.Kind() == reflect.Map {
+			rt = rt.Elem()
+		}
+		if rt.Kind() != reflect.Struct || rt == timeType || schemaIsOpaque(rt) || seen[rt] {
+			return
+		}
+		seen[rt] = true
+		if rt.Name() != "" {
+			var def objectSchema
+			if err := json.Unmarshal(schema.Defs[schemaDefName(rt)], &def); err != nil {
+				t.Fatalf("schema has no definition for %v: %v", rt, err)
+			}
+			props = def.Properties
+		}
+		for name, ft := range jsonFields(rt) {
+			prop, ok := props[name]
+			if !ok {
+				t.Errorf("schema of %v lacks field %s", rt, name)
+				continue
+			}
+			// Anonymous structs are described inline.
+			var inline objectSchema
+			json.Unmarshal(prop, &inline)
+			check(ft, inline.Properties)
+		}
+	}
+	check(reflect.TypeOf(HealthInfoV2{}), nil)
+	if len(seen) < 20 {
+		t.Fatalf("expected to check the nested structs, only checked %d", len(seen))
+	}
+}
+
+func TestHealthInfoV2SchemaFragments(t *testing.T) {
+	testCases := []struct {
+		def      string
+		expected string
+	}{
+		{
+			def: "madmin.HealthInfoV2",
+			expected: `{
+				"type": "object",
+				"properties": {
+					"version": {"type": "string"},
+					"error": {"type": "string"},
+					"timestamp": {"type": "string", "format": "date-time"},
+					"sys": {"$ref": "#/$defs/madmin.SysInfo"},
+					"perf": {"$ref": "#/$defs/madmin.PerfInfo"},
+					"minio": {"$ref": "#/$defs/madmin.MinioHealthInfo"}
+				},
+				"required": ["minio", "perf", "sys", "timestamp", "version"]
+			}`,
+		},
+		{
+			def: "madmin.SysErrors",
+			expected: `{
+				"type": "object",
+				"properties": {
+					"addr": {"type": "string"},
+					"error": {"type": "string"},
+					"errors": {"type": ["array", "null"], "items": {"type": "string"}}
+				},
+				"required": ["addr"]
+			}`,
+		},
+		{
+			def: "madmin.PerfInfo",
+			expected: `{
+				"type": "object",
+				"properties": {
+					"drives": {"type": ["array", "null"], "items": {"$ref": "#/$defs/madmin.DrivePerfInfos"}},
+					"net": {"type": ["array", "null"], "items": {"$ref": "#/$defs/madmin.NetPerfInfo"}},
+					"net_parallel": {"$ref": "#/$defs/madmin.NetPerfInfo"},
+					"collected_at": {"anyOf": [{"type": "string", "format": "date-time"}, {"type": "null"}]}
+				},
+				"required": ["net_parallel"]
+			}`,
+		},
+		{
+			def: "madmin.GCStats",
+			expected: `{
+				"type": "object",
+				"properties": {
+					"last_gc": {"type": "string", "format": "date-time"},
+					"num_gc": {"type": "integer"},
+					"pause_total": {"type": "integer"},
+					"pause": {"type": ["array", "null"], "items": {"type": "integer"}},
+					"pause_end": {"type": ["array", "null"], "items": {"type": "string", "format": "date-time"}}
+				},
+				"required": ["last_gc", "num_gc", "pause", "pause_end", "pause_total"]
+			}`,
+		},
+	}
+
+	var schema struct {
+		Ref  string                     `json:"$ref"`
+		Defs map[string]json.RawMessage `json:"$defs"`
+	}
+	if err := json.Unmarshal(HealthInfoV2Schema(), &schema); err != nil {
+		t.Fatal(err)
+	}
+	if schema.Ref != "#/$defs/madmin.HealthInfoV2" {
+		t.Fatalf("unexpected root reference %q", schema.Ref)
+	}
+	for i, tc := range testCases {
+		var expected, got interface{}
+		if err := json.Unmarshal([]byte(tc.expected), &expected); err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if err := json.Unmarshal(schema.Defs[tc.def], &got); err != nil {
+			t.Fatalf("Test %d: schema has no definition %s: %v", i+1, tc.def, err)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Fatalf("Test %d: unexpected schema of %s: %s", i+1, tc.def, schema.Defs[tc.def])
+		}
+	}
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"fmt"
+	"time"
+)
+
+// healthClockSkew is the tolerance for timestamps in the future.
+const healthClockSkew = 5 * time.Minute
+
+// Validate checks the health info for inconsistent values, such as free
+// exceeding total capacity, negative or unordered latency and throughput
+// percentiles and timestamps more than 5 minutes in the future. It
+// returns an error for each problem found, nil if there are none.
+func (info HealthInfoV2) Validate() []error {
+	var errs []error
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if info.TimeStamp.After(time.Now().Add(healthClockSkew)) {
+		addErr("timestamp %s is in the future", info.TimeStamp.Format(time.RFC3339))
+	}
+
+	for _, parts := range info.Sys.Partitions {
+		for _, p := range parts.Partitions {
+			if p.SpaceFree > p.SpaceTotal {
+				addErr("%s: drive %s: free space %d exceeds total %d", parts.Addr, p.Device, p.SpaceFree, p.SpaceTotal)
+			}
+			if p.InodeFree > p.InodeTotal {
+				addErr("%s: drive %s: free inodes %d exceed total %d", parts.Addr, p.Device, p.InodeFree, p.InodeTotal)
+			}
+		}
+	}
+	for _, m := range info.Sys.MemInfo {
+		if m.Total == 0 {
+			continue
+		}
+		for _, v := range []struct {
+			name  string
+			value uint64
+		}{{"used", m.Used}, {"free", m.Free}, {"available", m.Available}} {
+			if v.value > m.Total {
+				addErr("%s: %s memory %d exceeds total %d", m.Addr, v.name, v.value, m.Total)
+			}
+		}
+		if m.SwapSpaceFree > m.SwapSpaceTotal {
+			addErr("%s: free swap %d exceeds total %d", m.Addr, m.SwapSpaceFree, m.SwapSpaceTotal)
+		}
+	}
+
+	checkPerf := func(what string, l Latency, t Throughput) {
+		if l.Min < 0 || l.Avg < 0 || l.Max < 0 || l.Percentile50 < 0 || l.Percentile90 < 0 || l.Percentile99 < 0 {
+			addErr("%s: negative latency", what)
+		}
+		if l.Percentile50 > l.Percentile90 || l.Percentile90 > l.Percentile99 {
+			addErr("%s: latency percentiles out of order (p50=%v p90=%v p99=%v)", what, l.Percentile50, l.Percentile90, l.Percentile99)
+		}
+		if t.Percentile50 > t.Percentile90 || t.Percentile90 > t.Percentile99 {
+			addErr("%s: throughput percentiles out of order (p50=%v p90=%v p99=%v)", what, t.Percentile50, t.Percentile90, t.Percentile99)
+		}
+	}
+	for _, node := range info.Perf.Drives {
+		for _, perfs := range [][]DrivePerfInfo{node.SerialPerf, node.ParallelPerf} {
+			for _, d := range perfs {
+				if d.Error == "" {
+					checkPerf(node.Addr+": drive "+d.Path, d.Latency, d.Throughput)
+				}
+			}
+		}
+	}
+	checkNet := func(n NetPerfInfo) {
+		for _, peer := range n.RemotePeers {
+			if peer.Error == "" {
+				checkPerf(n.Addr+": peer "+peer.Addr, peer.Latency, peer.Throughput)
+			}
+		}
+	}
+	for _, n := range info.Perf.Net {
+		checkNet(n)
+	}
+	checkNet(info.Perf.NetParallel)
+	return errs
+}
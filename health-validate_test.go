@@ -0,0 +1,88 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHealthInfoV2Validate(t *testing.T) {
+	valid := HealthInfoV2{
+		TimeStamp: time.Now(),
+		Sys: SysInfo{
+			Partitions: []Partitions{{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				Partitions: []Partition{{Device: "/dev/sda", SpaceTotal: 100, SpaceFree: 40, InodeTotal: 10, InodeFree: 5}},
+			}},
+			MemInfo: []MemInfo{{NodeCommon: NodeCommon{Addr: "node1:9000"}, Total: 100, Used: 60, Available: 40}},
+		},
+		Perf: PerfInfo{
+			Drives: []DrivePerfInfos{{
+				NodeCommon: NodeCommon{Addr: "node1:9000"},
+				SerialPerf: []DrivePerfInfo{
+					{Path: "/mnt/drive1", Latency: Latency{Percentile50: 1, Percentile90: 2, Percentile99: 3}},
+					// Failed measurements are not validated.
+					{Path: "/mnt/drive2", Error: "offline", Latency: Latency{Percentile50: 3}},
+				},
+			}},
+		},
+	}
+	if errs := valid.Validate(); len(errs) != 0 {
+		t.Fatalf("expected valid health info, got %v", errs)
+	}
+
+	invalid := valid
+	invalid.TimeStamp = time.Now().Add(time.Hour)
+	invalid.Sys.Partitions = []Partitions{{
+		NodeCommon: NodeCommon{Addr: "node1:9000"},
+		Partitions: []Partition{{Device: "/dev/sda", SpaceTotal: 100, SpaceFree: 400}},
+	}}
+	invalid.Sys.MemInfo = []MemInfo{{NodeCommon: NodeCommon{Addr: "node1:9000"}, Total: 100, Available: 140}}
+	invalid.Perf.Net = []NetPerfInfo{{
+		NodeCommon: NodeCommon{Addr: "node1:9000"},
+		RemotePeers: []PeerNetPerfInfo{{
+			NodeCommon: NodeCommon{Addr: "node2:9000"},
+			Latency:    Latency{Percentile50: 3, Percentile90: 2, Percentile99: 1},
+			Throughput: Throughput{Percentile50: 10, Percentile90: 5, Percentile99: 20},
+		}},
+	}}
+
+	var msgs []string
+	for _, err := range invalid.Validate() {
+		msgs = append(msgs, err.Error())
+	}
+	want := []string{
+		"is in the future",
+		"node1:9000: drive /dev/sda: free space 400 exceeds total 100",
+		"node1:9000: available memory 140 exceeds total 100",
+		"node1:9000: peer node2:9000: latency percentiles out of order",
+		"node1:9000: peer node2:9000: throughput percentiles out of order",
+	}
+	if len(msgs) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), msgs)
+	}
+	for i, w := range want {
+		if !strings.Contains(msgs[i], w) {
+			t.Errorf("error %d: expected %q in %q", i+1, w, msgs[i])
+		}
+	}
+}
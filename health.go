@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -47,6 +48,27 @@ import (
 	"github.com/shirou/gopsutil/v3/process"
 )
 
+//msgp:clearomitted
+//msgp:tag json
+//go:generate msgp -file $GOFILE
+
+//msgp:ignore NodeCommon HealthReportVersion NodeInfo driveHwInfo SpeedTestResults HealthDataType HealthInfoVersionStruct
+//msgp:replace NodeCommon with:nodeCommon
+//msgp:shim ReplDiagInfo as:string using:replDiagInfoToMsgp/replDiagInfoFromMsgp mode:convert
+
+// replDiagInfoToMsgp keeps ReplDiagInfo, which holds third party
+// replication types, in its JSON form when encoded as msgpack.
+func replDiagInfoToMsgp(r ReplDiagInfo) (string, error) {
+	b, err := json.Marshal(r)
+	return string(b), err
+}
+
+// replDiagInfoFromMsgp decodes a ReplDiagInfo kept in its JSON form.
+func replDiagInfoFromMsgp(s string) (r ReplDiagInfo, err error) {
+	err = json.Unmarshal([]byte(s), &r)
+	return r, err
+}
+
 // NodeCommon - Common fields across most node-specific health structs
 type NodeCommon struct {
 	Addr  string `json:"addr"`
@@ -68,6 +90,23 @@ func (n *NodeCommon) SetError(err string) {
 	n.Error = err
 }
 
+// UnknownNodeAddr is the key GroupByNode uses for items without address.
+const UnknownNodeAddr = "unknown"
+
+// GroupByNode groups items by the node address returned by key.
+// Items without an address are grouped under UnknownNodeAddr.
+func GroupByNode[T any](items []T, key func(T) NodeCommon) map[string][]T {
+	groups := make(map[string][]T)
+	for _, item := range items {
+		addr := key(item).Addr
+		if addr == "" {
+			addr = UnknownNodeAddr
+		}
+		groups[addr] = append(groups[addr], item)
+	}
+	return groups
+}
+
 const (
 	// HealthInfoVersion0 is version 0
 	HealthInfoVersion0 = ""
@@ -81,6 +120,48 @@ const (
 	HealthInfoVersion = HealthInfoVersion3
 )
 
+// HealthReportVersion - typed health info version, the HealthInfoVersion*
+// constants can be used to switch on its value.
+type HealthReportVersion string
+
+// ParseHealthInfoVersion parses the version reported by the server
+// and returns an error if it is not a health info version supported by
+// this client. The legacy version 1 is known but not supported.
+func ParseHealthInfoVersion(version string) (HealthReportVersion, error) {
+	switch version {
+	case HealthInfoVersion0, HealthInfoVersion2, HealthInfoVersion3:
+		return HealthReportVersion(version), nil
+	case HealthInfoVersion1:
+		return "", fmt.Errorf("health info version %q is no longer supported", version)
+	}
+	return "", fmt.Errorf("unknown health info version %q", version)
+}
+
+// DecodeHealthReport decodes the health info objects of a stream, as
+// returned by ServerHealthInfo, into the struct of the given version:
+// a HealthInfoV0 for version 0 and a HealthInfoV2 for versions 2 and 3.
+// Each object supersedes the previous one.
+func DecodeHealthReport(version HealthReportVersion, r io.Reader) (HealthReport, error) {
+	switch version {
+	case HealthInfoVersion0:
+		var info HealthInfoV0
+		dec := json.NewDecoder(r)
+		for {
+			var next HealthInfoV0
+			if err := dec.Decode(&next); err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				return info, err
+			}
+			info = next
+		}
+	case HealthInfoVersion2, HealthInfoVersion3:
+		return DecodeHealthInfo(r)
+	}
+	return nil, fmt.Errorf("unsupported health info version %q", version)
+}
+
 const (
 	SysErrAuditEnabled      = "audit is enabled"
 	SysErrUpdatedbInstalled = "updatedb is installed"
@@ -134,8 +215,6 @@ type SysService struct {
 }
 
 // CPU contains system's CPU information.
-//
-//msgp:ignore CPU
 type CPU struct {
 	VendorID   string   `json:"vendor_id"`
 	Family     string   `json:"family"`
@@ -400,14 +479,6 @@ func GetPartitions(ctx context.Context, addr string) Partitions {
 	}
 }
 
-// OSInfo contains operating system's information.
-type OSInfo struct {
-	NodeCommon
-
-	Info    host.InfoStat          `json:"info,omitempty"`
-	Sensors []host.TemperatureStat `json:"sensors,omitempty"`
-}
-
 // TimeInfo contains current time with timezone, and
 // the roundtrip duration when fetching it remotely
 type TimeInfo struct {
@@ -778,39 +849,6 @@ func GetMemInfo(ctx context.Context, addr string) MemInfo {
 	}
 }
 
-// ProcInfo contains current process's information.
-type ProcInfo struct {
-	NodeCommon
-
-	PID            int32                      `json:"pid,omitempty"`
-	IsBackground   bool                       `json:"is_background,omitempty"`
-	CPUPercent     float64                    `json:"cpu_percent,omitempty"`
-	ChildrenPIDs   []int32                    `json:"children_pids,omitempty"`
-	CmdLine        string                     `json:"cmd_line,omitempty"`
-	NumConnections int                        `json:"num_connections,omitempty"`
-	CreateTime     int64                      `json:"create_time,omitempty"`
-	CWD            string                     `json:"cwd,omitempty"`
-	ExecPath       string                     `json:"exec_path,omitempty"`
-	GIDs           []int32                    `json:"gids,omitempty"`
-	IOCounters     process.IOCountersStat     `json:"iocounters,omitempty"`
-	IsRunning      bool                       `json:"is_running,omitempty"`
-	MemInfo        process.MemoryInfoStat     `json:"mem_info,omitempty"`
-	MemMaps        []process.MemoryMapsStat   `json:"mem_maps,omitempty"`
-	MemPercent     float32                    `json:"mem_percent,omitempty"`
-	Name           string                     `json:"name,omitempty"`
-	Nice           int32                      `json:"nice,omitempty"`
-	NumCtxSwitches process.NumCtxSwitchesStat `json:"num_ctx_switches,omitempty"`
-	NumFDs         int32                      `json:"num_fds,omitempty"`
-	NumThreads     int32                      `json:"num_threads,omitempty"`
-	PageFaults     process.PageFaultsStat     `json:"page_faults,omitempty"`
-	PPID           int32                      `json:"ppid,omitempty"`
-	Status         string                     `json:"status,omitempty"`
-	TGID           int32                      `json:"tgid,omitempty"`
-	Times          cpu.TimesStat              `json:"times,omitempty"`
-	UIDs           []int32                    `json:"uids,omitempty"`
-	Username       string                     `json:"username,omitempty"`
-}
-
 // GetProcInfo returns current MinIO process information.
 func GetProcInfo(ctx context.Context, addr string) ProcInfo {
 	pid := int32(syscall.Getpid())
@@ -1025,13 +1063,6 @@ type SpeedTestResults struct {
 	Error     string                 `json:"error,omitempty"`
 }
 
-// MinioConfig contains minio configuration of a node.
-type MinioConfig struct {
-	Error string `json:"error,omitempty"`
-
-	Config interface{} `json:"config,omitempty"`
-}
-
 // ServerInfo holds server information
 type ServerInfo struct {
 	State          string            `json:"state,omitempty"`
@@ -1053,26 +1084,6 @@ type ServerInfo struct {
 	License        *LicenseInfo      `json:"license,omitempty"`
 }
 
-// MinioInfo contains MinIO server and object storage information.
-type MinioInfo struct {
-	Mode         string           `json:"mode,omitempty"`
-	Domain       []string         `json:"domain,omitempty"`
-	Region       string           `json:"region,omitempty"`
-	SQSARN       []string         `json:"sqsARN,omitempty"`
-	DeploymentID string           `json:"deploymentID,omitempty"`
-	Buckets      Buckets          `json:"buckets,omitempty"`
-	Objects      Objects          `json:"objects,omitempty"`
-	Usage        Usage            `json:"usage,omitempty"`
-	Services     Services         `json:"services,omitempty"`
-	Backend      interface{}      `json:"backend,omitempty"`
-	Servers      []ServerInfo     `json:"servers,omitempty"`
-	TLS          *TLSInfo         `json:"tls"`
-	IsKubernetes *bool            `json:"is_kubernetes"`
-	IsDocker     *bool            `json:"is_docker"`
-	Metrics      *RealtimeMetrics `json:"metrics,omitempty"`
-	TierConfigs  []TierConfig     `json:"tier_configs,omitempty"`
-}
-
 type TLSInfo struct {
 	TLSEnabled bool      `json:"tls_enabled"`
 	Certs      []TLSCert `json:"certs,omitempty"`
@@ -1198,7 +1209,9 @@ type HealthInfoVersionStruct struct {
 }
 
 // ServerHealthInfo - Connect to a minio server and call Health Info Management API
-// to fetch server's information represented by HealthInfo structure
+// to fetch server's information represented by HealthInfo structure. The
+// returned version can be parsed with ParseHealthInfoVersion to decode the
+// response body with DecodeHealthReport.
 func (adm *AdminClient) ServerHealthInfo(ctx context.Context, types []HealthDataType, deadline time.Duration, anonymize string) (*http.Response, string, error) {
 	v := url.Values{}
 	v.Set("deadline", deadline.Truncate(1*time.Second).String())
@@ -1238,12 +1251,136 @@ func (adm *AdminClient) ServerHealthInfo(ctx context.Context, types []HealthData
 		return nil, "", errors.New(version.Error)
 	}
 
-	switch version.Version {
-	case "", HealthInfoVersion2, HealthInfoVersion:
-	default:
+	if _, err = ParseHealthInfoVersion(version.Version); err != nil {
 		closeResponse(resp)
-		return nil, "", errors.New("Upgrade Minio Client to support health info version " + version.Version)
+		if version.Version == HealthInfoVersion1 {
+			return nil, "", err
+		}
+		return nil, "", fmt.Errorf("%w, upgrade Minio Client to support it", err)
 	}
 
+	// Hand back any data already buffered by the decoder.
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(decoder.Buffered(), resp.Body), resp.Body}
+
 	return resp, version.Version, nil
 }
+
+// healthTimeoutErr is set on health info sections not received in time.
+const healthTimeoutErr = "timeout"
+
+// healthDeadlineMargin is the minimum time left between the deadline given
+// to the server and the client timeout, for the last results to arrive.
+const healthDeadlineMargin = time.Second
+
+// HealthInfoWithDeadline fetches the cluster health info, returning the data
+// received before deadline. The server is asked to finish collecting a tenth
+// of the deadline, and at least a second, earlier so the most complete
+// results are received in time. When the deadline is reached, the report is
+// returned with Error set to "timeout", as are the requested sections
+// that were not received.
+func (adm *AdminClient) HealthInfoWithDeadline(ctx context.Context, types []HealthDataType, deadline time.Duration, anonymize string) (HealthInfoV2, error) {
+	ctx, cancel := context.WithTimeout(ctx, deadline)
+	defer cancel()
+
+	serverDeadline := deadline
+	if margin := max(healthDeadlineMargin, deadline/10); deadline > margin {
+		serverDeadline = deadline - margin
+	}
+	resp, version, err := adm.ServerHealthInfo(ctx, types, serverDeadline, anonymize)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			info := HealthInfoV2{Error: healthTimeoutErr}
+			info.setHealthTimeout(types)
+			return info, nil
+		}
+		return HealthInfoV2{}, err
+	}
+	defer closeResponse(resp)
+
+	hv, err := ParseHealthInfoVersion(version)
+	if err != nil {
+		return HealthInfoV2{}, err
+	}
+	if hv == HealthInfoVersion0 {
+		return HealthInfoV2{}, errors.New("health info version 0 is not supported")
+	}
+
+	info, err := DecodeHealthInfo(resp.Body)
+	if info.Version == "" {
+		info.Version = version
+	}
+	// Perf results are measured while the report is generated, stamp them
+	// with the time they were received unless the server did.
+	if perf := &info.Perf; perf.CollectedAt == nil && (len(perf.Drives) > 0 || len(perf.Net) > 0 || perf.NetParallel.Addr != "") {
+		now := time.Now()
+		perf.CollectedAt = &now
+	}
+	if err != nil {
+		if ctx.Err() != context.DeadlineExceeded {
+			return info, err
+		}
+		if info.Error == "" {
+			info.Error = healthTimeoutErr
+		}
+		info.setHealthTimeout(types)
+	}
+	return info, nil
+}
+
+// setHealthTimeout marks the sections of the requested types
+// without any data as timed out.
+func (info *HealthInfoV2) setHealthTimeout(types []HealthDataType) {
+	timeout := NodeCommon{Error: healthTimeoutErr}
+	sys := &info.Sys
+	for _, t := range types {
+		switch t {
+		case HealthDataTypeMinioInfo, HealthDataTypeMinioConfig:
+			if info.Minio.Info.DeploymentID == "" && info.Minio.Config.Config == nil && info.Minio.Error == "" {
+				info.Minio.Error = healthTimeoutErr
+			}
+		case HealthDataTypeReplication:
+			if info.Minio.Replication == nil && info.Minio.Error == "" {
+				info.Minio.Error = healthTimeoutErr
+			}
+		case HealthDataTypeSysCPU:
+			if len(sys.CPUInfo) == 0 {
+				sys.CPUInfo = []CPUs{{NodeCommon: timeout}}
+			}
+		case HealthDataTypeSysDriveHw:
+			if len(sys.Partitions) == 0 {
+				sys.Partitions = []Partitions{{NodeCommon: timeout}}
+			}
+		case HealthDataTypeSysOsInfo:
+			if len(sys.OSInfo) == 0 {
+				sys.OSInfo = []OSInfo{{NodeCommon: timeout}}
+			}
+		case HealthDataTypeSysMem:
+			if len(sys.MemInfo) == 0 {
+				sys.MemInfo = []MemInfo{{NodeCommon: timeout}}
+			}
+		case HealthDataTypeSysNet:
+			if len(sys.NetInfo) == 0 {
+				sys.NetInfo = []NetInfo{{NodeCommon: timeout}}
+			}
+		case HealthDataTypeSysProcess:
+			if len(sys.ProcInfo) == 0 {
+				sys.ProcInfo = []ProcInfo{{NodeCommon: timeout}}
+			}
+		case HealthDataTypeSysErrors:
+			if len(sys.SysErrs) == 0 {
+				sys.SysErrs = []SysErrors{{NodeCommon: timeout}}
+			}
+		case HealthDataTypeSysServices:
+			if len(sys.SysServices) == 0 {
+				sys.SysServices = []SysServices{{NodeCommon: timeout}}
+			}
+		case HealthDataTypeSysConfig:
+			if len(sys.SysConfig) == 0 {
+				sys.SysConfig = []SysConfig{{NodeCommon: timeout}}
+			}
+		}
+	}
+}
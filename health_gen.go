@@ -0,0 +1,8375 @@
+package madmin
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *CPU) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "vendor_id":
+			z.VendorID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "VendorID")
+				return
+			}
+		case "family":
+			z.Family, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Family")
+				return
+			}
+		case "model":
+			z.Model, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Model")
+				return
+			}
+		case "stepping":
+			z.Stepping, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "Stepping")
+				return
+			}
+		case "physical_id":
+			z.PhysicalID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "PhysicalID")
+				return
+			}
+		case "model_name":
+			z.ModelName, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "ModelName")
+				return
+			}
+		case "mhz":
+			z.Mhz, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Mhz")
+				return
+			}
+		case "cache_size":
+			z.CacheSize, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "CacheSize")
+				return
+			}
+		case "flags":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Flags")
+				return
+			}
+			if cap(z.Flags) >= int(zb0002) {
+				z.Flags = (z.Flags)[:zb0002]
+			} else {
+				z.Flags = make([]string, zb0002)
+			}
+			for za0001 := range z.Flags {
+				z.Flags[za0001], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Flags", za0001)
+					return
+				}
+			}
+		case "microcode":
+			z.Microcode, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Microcode")
+				return
+			}
+		case "cores":
+			z.Cores, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Cores")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CPU) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 11
+	// write "vendor_id"
+	err = en.Append(0x8b, 0xa9, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.VendorID)
+	if err != nil {
+		err = msgp.WrapError(err, "VendorID")
+		return
+	}
+	// write "family"
+	err = en.Append(0xa6, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Family)
+	if err != nil {
+		err = msgp.WrapError(err, "Family")
+		return
+	}
+	// write "model"
+	err = en.Append(0xa5, 0x6d, 0x6f, 0x64, 0x65, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Model)
+	if err != nil {
+		err = msgp.WrapError(err, "Model")
+		return
+	}
+	// write "stepping"
+	err = en.Append(0xa8, 0x73, 0x74, 0x65, 0x70, 0x70, 0x69, 0x6e, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt32(z.Stepping)
+	if err != nil {
+		err = msgp.WrapError(err, "Stepping")
+		return
+	}
+	// write "physical_id"
+	err = en.Append(0xab, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.PhysicalID)
+	if err != nil {
+		err = msgp.WrapError(err, "PhysicalID")
+		return
+	}
+	// write "model_name"
+	err = en.Append(0xaa, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.ModelName)
+	if err != nil {
+		err = msgp.WrapError(err, "ModelName")
+		return
+	}
+	// write "mhz"
+	err = en.Append(0xa3, 0x6d, 0x68, 0x7a)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Mhz)
+	if err != nil {
+		err = msgp.WrapError(err, "Mhz")
+		return
+	}
+	// write "cache_size"
+	err = en.Append(0xaa, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt32(z.CacheSize)
+	if err != nil {
+		err = msgp.WrapError(err, "CacheSize")
+		return
+	}
+	// write "flags"
+	err = en.Append(0xa5, 0x66, 0x6c, 0x61, 0x67, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Flags)))
+	if err != nil {
+		err = msgp.WrapError(err, "Flags")
+		return
+	}
+	for za0001 := range z.Flags {
+		err = en.WriteString(z.Flags[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, "Flags", za0001)
+			return
+		}
+	}
+	// write "microcode"
+	err = en.Append(0xa9, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x63, 0x6f, 0x64, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Microcode)
+	if err != nil {
+		err = msgp.WrapError(err, "Microcode")
+		return
+	}
+	// write "cores"
+	err = en.Append(0xa5, 0x63, 0x6f, 0x72, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Cores)
+	if err != nil {
+		err = msgp.WrapError(err, "Cores")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CPU) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 11
+	// string "vendor_id"
+	o = append(o, 0x8b, 0xa9, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64)
+	o = msgp.AppendString(o, z.VendorID)
+	// string "family"
+	o = append(o, 0xa6, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79)
+	o = msgp.AppendString(o, z.Family)
+	// string "model"
+	o = append(o, 0xa5, 0x6d, 0x6f, 0x64, 0x65, 0x6c)
+	o = msgp.AppendString(o, z.Model)
+	// string "stepping"
+	o = append(o, 0xa8, 0x73, 0x74, 0x65, 0x70, 0x70, 0x69, 0x6e, 0x67)
+	o = msgp.AppendInt32(o, z.Stepping)
+	// string "physical_id"
+	o = append(o, 0xab, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64)
+	o = msgp.AppendString(o, z.PhysicalID)
+	// string "model_name"
+	o = append(o, 0xaa, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.ModelName)
+	// string "mhz"
+	o = append(o, 0xa3, 0x6d, 0x68, 0x7a)
+	o = msgp.AppendFloat64(o, z.Mhz)
+	// string "cache_size"
+	o = append(o, 0xaa, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65)
+	o = msgp.AppendInt32(o, z.CacheSize)
+	// string "flags"
+	o = append(o, 0xa5, 0x66, 0x6c, 0x61, 0x67, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Flags)))
+	for za0001 := range z.Flags {
+		o = msgp.AppendString(o, z.Flags[za0001])
+	}
+	// string "microcode"
+	o = append(o, 0xa9, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x63, 0x6f, 0x64, 0x65)
+	o = msgp.AppendString(o, z.Microcode)
+	// string "cores"
+	o = append(o, 0xa5, 0x63, 0x6f, 0x72, 0x65, 0x73)
+	o = msgp.AppendInt(o, z.Cores)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CPU) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "vendor_id":
+			z.VendorID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VendorID")
+				return
+			}
+		case "family":
+			z.Family, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Family")
+				return
+			}
+		case "model":
+			z.Model, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Model")
+				return
+			}
+		case "stepping":
+			z.Stepping, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Stepping")
+				return
+			}
+		case "physical_id":
+			z.PhysicalID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PhysicalID")
+				return
+			}
+		case "model_name":
+			z.ModelName, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ModelName")
+				return
+			}
+		case "mhz":
+			z.Mhz, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Mhz")
+				return
+			}
+		case "cache_size":
+			z.CacheSize, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CacheSize")
+				return
+			}
+		case "flags":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Flags")
+				return
+			}
+			if cap(z.Flags) >= int(zb0002) {
+				z.Flags = (z.Flags)[:zb0002]
+			} else {
+				z.Flags = make([]string, zb0002)
+			}
+			for za0001 := range z.Flags {
+				z.Flags[za0001], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Flags", za0001)
+					return
+				}
+			}
+		case "microcode":
+			z.Microcode, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Microcode")
+				return
+			}
+		case "cores":
+			z.Cores, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Cores")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CPU) Msgsize() (s int) {
+	s = 1 + 10 + msgp.StringPrefixSize + len(z.VendorID) + 7 + msgp.StringPrefixSize + len(z.Family) + 6 + msgp.StringPrefixSize + len(z.Model) + 9 + msgp.Int32Size + 12 + msgp.StringPrefixSize + len(z.PhysicalID) + 11 + msgp.StringPrefixSize + len(z.ModelName) + 4 + msgp.Float64Size + 11 + msgp.Int32Size + 6 + msgp.ArrayHeaderSize
+	for za0001 := range z.Flags {
+		s += msgp.StringPrefixSize + len(z.Flags[za0001])
+	}
+	s += 10 + msgp.StringPrefixSize + len(z.Microcode) + 6 + msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *CPUFreqStats) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "CpuinfoCurrentFrequency":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoCurrentFrequency")
+					return
+				}
+				z.CpuinfoCurrentFrequency = nil
+			} else {
+				if z.CpuinfoCurrentFrequency == nil {
+					z.CpuinfoCurrentFrequency = new(uint64)
+				}
+				*z.CpuinfoCurrentFrequency, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoCurrentFrequency")
+					return
+				}
+			}
+		case "CpuinfoMinimumFrequency":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoMinimumFrequency")
+					return
+				}
+				z.CpuinfoMinimumFrequency = nil
+			} else {
+				if z.CpuinfoMinimumFrequency == nil {
+					z.CpuinfoMinimumFrequency = new(uint64)
+				}
+				*z.CpuinfoMinimumFrequency, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoMinimumFrequency")
+					return
+				}
+			}
+		case "CpuinfoMaximumFrequency":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoMaximumFrequency")
+					return
+				}
+				z.CpuinfoMaximumFrequency = nil
+			} else {
+				if z.CpuinfoMaximumFrequency == nil {
+					z.CpuinfoMaximumFrequency = new(uint64)
+				}
+				*z.CpuinfoMaximumFrequency, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoMaximumFrequency")
+					return
+				}
+			}
+		case "CpuinfoTransitionLatency":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoTransitionLatency")
+					return
+				}
+				z.CpuinfoTransitionLatency = nil
+			} else {
+				if z.CpuinfoTransitionLatency == nil {
+					z.CpuinfoTransitionLatency = new(uint64)
+				}
+				*z.CpuinfoTransitionLatency, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoTransitionLatency")
+					return
+				}
+			}
+		case "ScalingCurrentFrequency":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingCurrentFrequency")
+					return
+				}
+				z.ScalingCurrentFrequency = nil
+			} else {
+				if z.ScalingCurrentFrequency == nil {
+					z.ScalingCurrentFrequency = new(uint64)
+				}
+				*z.ScalingCurrentFrequency, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingCurrentFrequency")
+					return
+				}
+			}
+		case "ScalingMinimumFrequency":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingMinimumFrequency")
+					return
+				}
+				z.ScalingMinimumFrequency = nil
+			} else {
+				if z.ScalingMinimumFrequency == nil {
+					z.ScalingMinimumFrequency = new(uint64)
+				}
+				*z.ScalingMinimumFrequency, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingMinimumFrequency")
+					return
+				}
+			}
+		case "ScalingMaximumFrequency":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingMaximumFrequency")
+					return
+				}
+				z.ScalingMaximumFrequency = nil
+			} else {
+				if z.ScalingMaximumFrequency == nil {
+					z.ScalingMaximumFrequency = new(uint64)
+				}
+				*z.ScalingMaximumFrequency, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingMaximumFrequency")
+					return
+				}
+			}
+		case "AvailableGovernors":
+			z.AvailableGovernors, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "AvailableGovernors")
+				return
+			}
+		case "Driver":
+			z.Driver, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Driver")
+				return
+			}
+		case "Governor":
+			z.Governor, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Governor")
+				return
+			}
+		case "RelatedCpus":
+			z.RelatedCpus, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "RelatedCpus")
+				return
+			}
+		case "SetSpeed":
+			z.SetSpeed, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "SetSpeed")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CPUFreqStats) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 13
+	// write "Name"
+	err = en.Append(0x8d, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "CpuinfoCurrentFrequency"
+	err = en.Append(0xb7, 0x43, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if err != nil {
+		return
+	}
+	if z.CpuinfoCurrentFrequency == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteUint64(*z.CpuinfoCurrentFrequency)
+		if err != nil {
+			err = msgp.WrapError(err, "CpuinfoCurrentFrequency")
+			return
+		}
+	}
+	// write "CpuinfoMinimumFrequency"
+	err = en.Append(0xb7, 0x43, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if err != nil {
+		return
+	}
+	if z.CpuinfoMinimumFrequency == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteUint64(*z.CpuinfoMinimumFrequency)
+		if err != nil {
+			err = msgp.WrapError(err, "CpuinfoMinimumFrequency")
+			return
+		}
+	}
+	// write "CpuinfoMaximumFrequency"
+	err = en.Append(0xb7, 0x43, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if err != nil {
+		return
+	}
+	if z.CpuinfoMaximumFrequency == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteUint64(*z.CpuinfoMaximumFrequency)
+		if err != nil {
+			err = msgp.WrapError(err, "CpuinfoMaximumFrequency")
+			return
+		}
+	}
+	// write "CpuinfoTransitionLatency"
+	err = en.Append(0xb8, 0x43, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79)
+	if err != nil {
+		return
+	}
+	if z.CpuinfoTransitionLatency == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteUint64(*z.CpuinfoTransitionLatency)
+		if err != nil {
+			err = msgp.WrapError(err, "CpuinfoTransitionLatency")
+			return
+		}
+	}
+	// write "ScalingCurrentFrequency"
+	err = en.Append(0xb7, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if err != nil {
+		return
+	}
+	if z.ScalingCurrentFrequency == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteUint64(*z.ScalingCurrentFrequency)
+		if err != nil {
+			err = msgp.WrapError(err, "ScalingCurrentFrequency")
+			return
+		}
+	}
+	// write "ScalingMinimumFrequency"
+	err = en.Append(0xb7, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if err != nil {
+		return
+	}
+	if z.ScalingMinimumFrequency == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteUint64(*z.ScalingMinimumFrequency)
+		if err != nil {
+			err = msgp.WrapError(err, "ScalingMinimumFrequency")
+			return
+		}
+	}
+	// write "ScalingMaximumFrequency"
+	err = en.Append(0xb7, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if err != nil {
+		return
+	}
+	if z.ScalingMaximumFrequency == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteUint64(*z.ScalingMaximumFrequency)
+		if err != nil {
+			err = msgp.WrapError(err, "ScalingMaximumFrequency")
+			return
+		}
+	}
+	// write "AvailableGovernors"
+	err = en.Append(0xb2, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.AvailableGovernors)
+	if err != nil {
+		err = msgp.WrapError(err, "AvailableGovernors")
+		return
+	}
+	// write "Driver"
+	err = en.Append(0xa6, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Driver)
+	if err != nil {
+		err = msgp.WrapError(err, "Driver")
+		return
+	}
+	// write "Governor"
+	err = en.Append(0xa8, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Governor)
+	if err != nil {
+		err = msgp.WrapError(err, "Governor")
+		return
+	}
+	// write "RelatedCpus"
+	err = en.Append(0xab, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x70, 0x75, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.RelatedCpus)
+	if err != nil {
+		err = msgp.WrapError(err, "RelatedCpus")
+		return
+	}
+	// write "SetSpeed"
+	err = en.Append(0xa8, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.SetSpeed)
+	if err != nil {
+		err = msgp.WrapError(err, "SetSpeed")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CPUFreqStats) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 13
+	// string "Name"
+	o = append(o, 0x8d, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "CpuinfoCurrentFrequency"
+	o = append(o, 0xb7, 0x43, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if z.CpuinfoCurrentFrequency == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendUint64(o, *z.CpuinfoCurrentFrequency)
+	}
+	// string "CpuinfoMinimumFrequency"
+	o = append(o, 0xb7, 0x43, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if z.CpuinfoMinimumFrequency == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendUint64(o, *z.CpuinfoMinimumFrequency)
+	}
+	// string "CpuinfoMaximumFrequency"
+	o = append(o, 0xb7, 0x43, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if z.CpuinfoMaximumFrequency == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendUint64(o, *z.CpuinfoMaximumFrequency)
+	}
+	// string "CpuinfoTransitionLatency"
+	o = append(o, 0xb8, 0x43, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79)
+	if z.CpuinfoTransitionLatency == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendUint64(o, *z.CpuinfoTransitionLatency)
+	}
+	// string "ScalingCurrentFrequency"
+	o = append(o, 0xb7, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if z.ScalingCurrentFrequency == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendUint64(o, *z.ScalingCurrentFrequency)
+	}
+	// string "ScalingMinimumFrequency"
+	o = append(o, 0xb7, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if z.ScalingMinimumFrequency == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendUint64(o, *z.ScalingMinimumFrequency)
+	}
+	// string "ScalingMaximumFrequency"
+	o = append(o, 0xb7, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79)
+	if z.ScalingMaximumFrequency == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendUint64(o, *z.ScalingMaximumFrequency)
+	}
+	// string "AvailableGovernors"
+	o = append(o, 0xb2, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x73)
+	o = msgp.AppendString(o, z.AvailableGovernors)
+	// string "Driver"
+	o = append(o, 0xa6, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72)
+	o = msgp.AppendString(o, z.Driver)
+	// string "Governor"
+	o = append(o, 0xa8, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72)
+	o = msgp.AppendString(o, z.Governor)
+	// string "RelatedCpus"
+	o = append(o, 0xab, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x70, 0x75, 0x73)
+	o = msgp.AppendString(o, z.RelatedCpus)
+	// string "SetSpeed"
+	o = append(o, 0xa8, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64)
+	o = msgp.AppendString(o, z.SetSpeed)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CPUFreqStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "CpuinfoCurrentFrequency":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.CpuinfoCurrentFrequency = nil
+			} else {
+				if z.CpuinfoCurrentFrequency == nil {
+					z.CpuinfoCurrentFrequency = new(uint64)
+				}
+				*z.CpuinfoCurrentFrequency, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoCurrentFrequency")
+					return
+				}
+			}
+		case "CpuinfoMinimumFrequency":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.CpuinfoMinimumFrequency = nil
+			} else {
+				if z.CpuinfoMinimumFrequency == nil {
+					z.CpuinfoMinimumFrequency = new(uint64)
+				}
+				*z.CpuinfoMinimumFrequency, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoMinimumFrequency")
+					return
+				}
+			}
+		case "CpuinfoMaximumFrequency":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.CpuinfoMaximumFrequency = nil
+			} else {
+				if z.CpuinfoMaximumFrequency == nil {
+					z.CpuinfoMaximumFrequency = new(uint64)
+				}
+				*z.CpuinfoMaximumFrequency, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoMaximumFrequency")
+					return
+				}
+			}
+		case "CpuinfoTransitionLatency":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.CpuinfoTransitionLatency = nil
+			} else {
+				if z.CpuinfoTransitionLatency == nil {
+					z.CpuinfoTransitionLatency = new(uint64)
+				}
+				*z.CpuinfoTransitionLatency, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CpuinfoTransitionLatency")
+					return
+				}
+			}
+		case "ScalingCurrentFrequency":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.ScalingCurrentFrequency = nil
+			} else {
+				if z.ScalingCurrentFrequency == nil {
+					z.ScalingCurrentFrequency = new(uint64)
+				}
+				*z.ScalingCurrentFrequency, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingCurrentFrequency")
+					return
+				}
+			}
+		case "ScalingMinimumFrequency":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.ScalingMinimumFrequency = nil
+			} else {
+				if z.ScalingMinimumFrequency == nil {
+					z.ScalingMinimumFrequency = new(uint64)
+				}
+				*z.ScalingMinimumFrequency, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingMinimumFrequency")
+					return
+				}
+			}
+		case "ScalingMaximumFrequency":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.ScalingMaximumFrequency = nil
+			} else {
+				if z.ScalingMaximumFrequency == nil {
+					z.ScalingMaximumFrequency = new(uint64)
+				}
+				*z.ScalingMaximumFrequency, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ScalingMaximumFrequency")
+					return
+				}
+			}
+		case "AvailableGovernors":
+			z.AvailableGovernors, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AvailableGovernors")
+				return
+			}
+		case "Driver":
+			z.Driver, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Driver")
+				return
+			}
+		case "Governor":
+			z.Governor, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Governor")
+				return
+			}
+		case "RelatedCpus":
+			z.RelatedCpus, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RelatedCpus")
+				return
+			}
+		case "SetSpeed":
+			z.SetSpeed, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SetSpeed")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CPUFreqStats) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 24
+	if z.CpuinfoCurrentFrequency == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.Uint64Size
+	}
+	s += 24
+	if z.CpuinfoMinimumFrequency == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.Uint64Size
+	}
+	s += 24
+	if z.CpuinfoMaximumFrequency == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.Uint64Size
+	}
+	s += 25
+	if z.CpuinfoTransitionLatency == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.Uint64Size
+	}
+	s += 24
+	if z.ScalingCurrentFrequency == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.Uint64Size
+	}
+	s += 24
+	if z.ScalingMinimumFrequency == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.Uint64Size
+	}
+	s += 24
+	if z.ScalingMaximumFrequency == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.Uint64Size
+	}
+	s += 19 + msgp.StringPrefixSize + len(z.AvailableGovernors) + 7 + msgp.StringPrefixSize + len(z.Driver) + 9 + msgp.StringPrefixSize + len(z.Governor) + 12 + msgp.StringPrefixSize + len(z.RelatedCpus) + 9 + msgp.StringPrefixSize + len(z.SetSpeed)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *CPUs) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "cpus":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "CPUs")
+				return
+			}
+			if cap(z.CPUs) >= int(zb0002) {
+				z.CPUs = (z.CPUs)[:zb0002]
+			} else {
+				z.CPUs = make([]CPU, zb0002)
+			}
+			for za0001 := range z.CPUs {
+				err = z.CPUs[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUs", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		case "freq_stats":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "CPUFreqStats")
+				return
+			}
+			if cap(z.CPUFreqStats) >= int(zb0003) {
+				z.CPUFreqStats = (z.CPUFreqStats)[:zb0003]
+			} else {
+				z.CPUFreqStats = make([]CPUFreqStats, zb0003)
+			}
+			for za0002 := range z.CPUFreqStats {
+				err = z.CPUFreqStats[za0002].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUFreqStats", za0002)
+					return
+				}
+			}
+			zb0001Mask |= 0x2
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.CPUs = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.CPUFreqStats = nil
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CPUs) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	if z.CPUs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.CPUFreqStats == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "cpus"
+			err = en.Append(0xa4, 0x63, 0x70, 0x75, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.CPUs)))
+			if err != nil {
+				err = msgp.WrapError(err, "CPUs")
+				return
+			}
+			for za0001 := range z.CPUs {
+				err = z.CPUs[za0001].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUs", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "freq_stats"
+			err = en.Append(0xaa, 0x66, 0x72, 0x65, 0x71, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.CPUFreqStats)))
+			if err != nil {
+				err = msgp.WrapError(err, "CPUFreqStats")
+				return
+			}
+			for za0002 := range z.CPUFreqStats {
+				err = z.CPUFreqStats[za0002].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUFreqStats", za0002)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CPUs) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	if z.CPUs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.CPUFreqStats == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "cpus"
+			o = append(o, 0xa4, 0x63, 0x70, 0x75, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.CPUs)))
+			for za0001 := range z.CPUs {
+				o, err = z.CPUs[za0001].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUs", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "freq_stats"
+			o = append(o, 0xaa, 0x66, 0x72, 0x65, 0x71, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.CPUFreqStats)))
+			for za0002 := range z.CPUFreqStats {
+				o, err = z.CPUFreqStats[za0002].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUFreqStats", za0002)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CPUs) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "cpus":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CPUs")
+				return
+			}
+			if cap(z.CPUs) >= int(zb0002) {
+				z.CPUs = (z.CPUs)[:zb0002]
+			} else {
+				z.CPUs = make([]CPU, zb0002)
+			}
+			for za0001 := range z.CPUs {
+				bts, err = z.CPUs[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUs", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		case "freq_stats":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CPUFreqStats")
+				return
+			}
+			if cap(z.CPUFreqStats) >= int(zb0003) {
+				z.CPUFreqStats = (z.CPUFreqStats)[:zb0003]
+			} else {
+				z.CPUFreqStats = make([]CPUFreqStats, zb0003)
+			}
+			for za0002 := range z.CPUFreqStats {
+				bts, err = z.CPUFreqStats[za0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUFreqStats", za0002)
+					return
+				}
+			}
+			zb0001Mask |= 0x2
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.CPUs = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.CPUFreqStats = nil
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CPUs) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 5 + msgp.ArrayHeaderSize
+	for za0001 := range z.CPUs {
+		s += z.CPUs[za0001].Msgsize()
+	}
+	s += 11 + msgp.ArrayHeaderSize
+	for za0002 := range z.CPUFreqStats {
+		s += z.CPUFreqStats[za0002].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *HealthInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "version":
+			z.Version, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		case "error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "timestamp":
+			z.TimeStamp, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "TimeStamp")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "sys":
+			err = z.Sys.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Sys")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "minio":
+			err = z.Minio.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Minio")
+				return
+			}
+			zb0001Mask |= 0x8
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xf {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.TimeStamp = (time.Time{})
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Sys = SysInfo{}
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Minio = MinioHealthInfo{}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *HealthInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 5 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.TimeStamp == (time.Time{}) {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "version"
+		err = en.Append(0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Version)
+		if err != nil {
+			err = msgp.WrapError(err, "Version")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "error"
+			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Error)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "timestamp"
+			err = en.Append(0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+			if err != nil {
+				return
+			}
+			err = en.WriteTime(z.TimeStamp)
+			if err != nil {
+				err = msgp.WrapError(err, "TimeStamp")
+				return
+			}
+		}
+		// write "sys"
+		err = en.Append(0xa3, 0x73, 0x79, 0x73)
+		if err != nil {
+			return
+		}
+		err = z.Sys.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Sys")
+			return
+		}
+		// write "minio"
+		err = en.Append(0xa5, 0x6d, 0x69, 0x6e, 0x69, 0x6f)
+		if err != nil {
+			return
+		}
+		err = z.Minio.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Minio")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *HealthInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 5 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.TimeStamp == (time.Time{}) {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "version"
+		o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+		o = msgp.AppendString(o, z.Version)
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "error"
+			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Error)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "timestamp"
+			o = append(o, 0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+			o = msgp.AppendTime(o, z.TimeStamp)
+		}
+		// string "sys"
+		o = append(o, 0xa3, 0x73, 0x79, 0x73)
+		o, err = z.Sys.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Sys")
+			return
+		}
+		// string "minio"
+		o = append(o, 0xa5, 0x6d, 0x69, 0x6e, 0x69, 0x6f)
+		o, err = z.Minio.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Minio")
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *HealthInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "version":
+			z.Version, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		case "error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "timestamp":
+			z.TimeStamp, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TimeStamp")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "sys":
+			bts, err = z.Sys.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Sys")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "minio":
+			bts, err = z.Minio.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Minio")
+				return
+			}
+			zb0001Mask |= 0x8
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xf {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.TimeStamp = (time.Time{})
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Sys = SysInfo{}
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Minio = MinioHealthInfo{}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *HealthInfo) Msgsize() (s int) {
+	s = 1 + 8 + msgp.StringPrefixSize + len(z.Version) + 6 + msgp.StringPrefixSize + len(z.Error) + 10 + msgp.TimeSize + 4 + z.Sys.Msgsize() + 6 + z.Minio.Msgsize()
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *KubernetesInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 7 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "major":
+			z.Major, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Major")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "minor":
+			z.Minor, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Minor")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "gitVersion":
+			z.GitVersion, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "GitVersion")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "gitCommit":
+			z.GitCommit, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "GitCommit")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "buildDate":
+			z.BuildDate, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "BuildDate")
+				return
+			}
+			zb0001Mask |= 0x10
+		case "platform":
+			z.Platform, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Platform")
+				return
+			}
+			zb0001Mask |= 0x20
+		case "error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x40
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x7f {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Major = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Minor = ""
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.GitVersion = ""
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.GitCommit = ""
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.BuildDate = (time.Time{})
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.Platform = ""
+		}
+		if (zb0001Mask & 0x40) == 0 {
+			z.Error = ""
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *KubernetesInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
+	_ = zb0001Mask
+	if z.Major == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Minor == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.GitVersion == "" {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.GitCommit == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.BuildDate == (time.Time{}) {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Platform == "" {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "major"
+			err = en.Append(0xa5, 0x6d, 0x61, 0x6a, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Major)
+			if err != nil {
+				err = msgp.WrapError(err, "Major")
+				return
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "minor"
+			err = en.Append(0xa5, 0x6d, 0x69, 0x6e, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Minor)
+			if err != nil {
+				err = msgp.WrapError(err, "Minor")
+				return
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "gitVersion"
+			err = en.Append(0xaa, 0x67, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.GitVersion)
+			if err != nil {
+				err = msgp.WrapError(err, "GitVersion")
+				return
+			}
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// write "gitCommit"
+			err = en.Append(0xa9, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.GitCommit)
+			if err != nil {
+				err = msgp.WrapError(err, "GitCommit")
+				return
+			}
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// write "buildDate"
+			err = en.Append(0xa9, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteTime(z.BuildDate)
+			if err != nil {
+				err = msgp.WrapError(err, "BuildDate")
+				return
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// write "platform"
+			err = en.Append(0xa8, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Platform)
+			if err != nil {
+				err = msgp.WrapError(err, "Platform")
+				return
+			}
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// write "error"
+			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Error)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *KubernetesInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
+	_ = zb0001Mask
+	if z.Major == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Minor == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.GitVersion == "" {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.GitCommit == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.BuildDate == (time.Time{}) {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Platform == "" {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "major"
+			o = append(o, 0xa5, 0x6d, 0x61, 0x6a, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Major)
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "minor"
+			o = append(o, 0xa5, 0x6d, 0x69, 0x6e, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Minor)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "gitVersion"
+			o = append(o, 0xaa, 0x67, 0x69, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+			o = msgp.AppendString(o, z.GitVersion)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// string "gitCommit"
+			o = append(o, 0xa9, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74)
+			o = msgp.AppendString(o, z.GitCommit)
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// string "buildDate"
+			o = append(o, 0xa9, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65)
+			o = msgp.AppendTime(o, z.BuildDate)
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// string "platform"
+			o = append(o, 0xa8, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d)
+			o = msgp.AppendString(o, z.Platform)
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// string "error"
+			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Error)
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *KubernetesInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 7 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "major":
+			z.Major, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Major")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "minor":
+			z.Minor, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Minor")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "gitVersion":
+			z.GitVersion, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "GitVersion")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "gitCommit":
+			z.GitCommit, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "GitCommit")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "buildDate":
+			z.BuildDate, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BuildDate")
+				return
+			}
+			zb0001Mask |= 0x10
+		case "platform":
+			z.Platform, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Platform")
+				return
+			}
+			zb0001Mask |= 0x20
+		case "error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x40
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x7f {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Major = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Minor = ""
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.GitVersion = ""
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.GitCommit = ""
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.BuildDate = (time.Time{})
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.Platform = ""
+		}
+		if (zb0001Mask & 0x40) == 0 {
+			z.Error = ""
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *KubernetesInfo) Msgsize() (s int) {
+	s = 1 + 6 + msgp.StringPrefixSize + len(z.Major) + 6 + msgp.StringPrefixSize + len(z.Minor) + 11 + msgp.StringPrefixSize + len(z.GitVersion) + 10 + msgp.StringPrefixSize + len(z.GitCommit) + 10 + msgp.TimeSize + 9 + msgp.StringPrefixSize + len(z.Platform) + 6 + msgp.StringPrefixSize + len(z.Error)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *MinioHealthInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "config":
+			err = z.Config.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Config")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "info":
+			err = z.Info.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Info")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "replication":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Replication")
+					return
+				}
+				z.Replication = nil
+			} else {
+				if z.Replication == nil {
+					z.Replication = new(ReplDiagInfo)
+				}
+				{
+					var zb0002 string
+					zb0002, err = dc.ReadString()
+					if err != nil {
+						err = msgp.WrapError(err, "Replication")
+						return
+					}
+					*z.Replication, err = replDiagInfoFromMsgp(zb0002)
+				}
+				if err != nil {
+					err = msgp.WrapError(err, "Replication")
+					return
+				}
+			}
+			zb0001Mask |= 0x8
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xf {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Config = MinioConfig{}
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Info = MinioInfo{}
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Replication = nil
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *MinioHealthInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(4)
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Replication == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "error"
+			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Error)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		}
+		// write "config"
+		err = en.Append(0xa6, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67)
+		if err != nil {
+			return
+		}
+		err = z.Config.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Config")
+			return
+		}
+		// write "info"
+		err = en.Append(0xa4, 0x69, 0x6e, 0x66, 0x6f)
+		if err != nil {
+			return
+		}
+		err = z.Info.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Info")
+			return
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// write "replication"
+			err = en.Append(0xab, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e)
+			if err != nil {
+				return
+			}
+			if z.Replication == nil {
+				err = en.WriteNil()
+				if err != nil {
+					return
+				}
+			} else {
+				var zb0002 string
+				zb0002, err = replDiagInfoToMsgp(*z.Replication)
+				if err != nil {
+					err = msgp.WrapError(err, "Replication")
+					return
+				}
+				err = en.WriteString(zb0002)
+				if err != nil {
+					err = msgp.WrapError(err, "Replication")
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *MinioHealthInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(4)
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Replication == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "error"
+			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Error)
+		}
+		// string "config"
+		o = append(o, 0xa6, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67)
+		o, err = z.Config.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Config")
+			return
+		}
+		// string "info"
+		o = append(o, 0xa4, 0x69, 0x6e, 0x66, 0x6f)
+		o, err = z.Info.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Info")
+			return
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// string "replication"
+			o = append(o, 0xab, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e)
+			if z.Replication == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				var zb0002 string
+				zb0002, err = replDiagInfoToMsgp(*z.Replication)
+				if err != nil {
+					err = msgp.WrapError(err, "Replication")
+					return
+				}
+				o = msgp.AppendString(o, zb0002)
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *MinioHealthInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "config":
+			bts, err = z.Config.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Config")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "info":
+			bts, err = z.Info.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Info")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "replication":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Replication = nil
+			} else {
+				if z.Replication == nil {
+					z.Replication = new(ReplDiagInfo)
+				}
+				{
+					var zb0002 string
+					zb0002, bts, err = msgp.ReadStringBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Replication")
+						return
+					}
+					*z.Replication, err = replDiagInfoFromMsgp(zb0002)
+
+					if err != nil {
+						err = msgp.WrapError(err, "Replication")
+						return
+					}
+				}
+			}
+			zb0001Mask |= 0x8
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xf {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Config = MinioConfig{}
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Info = MinioInfo{}
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Replication = nil
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *MinioHealthInfo) Msgsize() (s int) {
+	s = 1 + 6 + msgp.StringPrefixSize + len(z.Error) + 7 + z.Config.Msgsize() + 5 + z.Info.Msgsize() + 12
+	if z.Replication == nil {
+		s += msgp.NilSize
+	} else {
+		var zb0001 string
+		_ = *z.Replication
+		s += msgp.StringPrefixSize + len(zb0001)
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *NetInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "interface":
+			z.Interface, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Interface")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "driver":
+			z.Driver, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Driver")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "firmware_version":
+			z.FirmwareVersion, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "FirmwareVersion")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "settings":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Settings")
+					return
+				}
+				z.Settings = nil
+			} else {
+				if z.Settings == nil {
+					z.Settings = new(NetSettings)
+				}
+				err = z.Settings.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Settings")
+					return
+				}
+			}
+			zb0001Mask |= 0x8
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xf {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Interface = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Driver = ""
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.FirmwareVersion = ""
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Settings = nil
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *NetInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 5 bits */
+	_ = zb0001Mask
+	if z.Interface == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Driver == "" {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.FirmwareVersion == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.Settings == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "interface"
+			err = en.Append(0xa9, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Interface)
+			if err != nil {
+				err = msgp.WrapError(err, "Interface")
+				return
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "driver"
+			err = en.Append(0xa6, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Driver)
+			if err != nil {
+				err = msgp.WrapError(err, "Driver")
+				return
+			}
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// write "firmware_version"
+			err = en.Append(0xb0, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.FirmwareVersion)
+			if err != nil {
+				err = msgp.WrapError(err, "FirmwareVersion")
+				return
+			}
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// write "settings"
+			err = en.Append(0xa8, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73)
+			if err != nil {
+				return
+			}
+			if z.Settings == nil {
+				err = en.WriteNil()
+				if err != nil {
+					return
+				}
+			} else {
+				err = z.Settings.EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Settings")
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *NetInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 5 bits */
+	_ = zb0001Mask
+	if z.Interface == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Driver == "" {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.FirmwareVersion == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.Settings == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "interface"
+			o = append(o, 0xa9, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65)
+			o = msgp.AppendString(o, z.Interface)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "driver"
+			o = append(o, 0xa6, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72)
+			o = msgp.AppendString(o, z.Driver)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// string "firmware_version"
+			o = append(o, 0xb0, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+			o = msgp.AppendString(o, z.FirmwareVersion)
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// string "settings"
+			o = append(o, 0xa8, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73)
+			if z.Settings == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o, err = z.Settings.MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Settings")
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *NetInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 4 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "interface":
+			z.Interface, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Interface")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "driver":
+			z.Driver, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Driver")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "firmware_version":
+			z.FirmwareVersion, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FirmwareVersion")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "settings":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Settings = nil
+			} else {
+				if z.Settings == nil {
+					z.Settings = new(NetSettings)
+				}
+				bts, err = z.Settings.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Settings")
+					return
+				}
+			}
+			zb0001Mask |= 0x8
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xf {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Interface = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Driver = ""
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.FirmwareVersion = ""
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Settings = nil
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *NetInfo) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 10 + msgp.StringPrefixSize + len(z.Interface) + 7 + msgp.StringPrefixSize + len(z.Driver) + 17 + msgp.StringPrefixSize + len(z.FirmwareVersion) + 9
+	if z.Settings == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Settings.Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *NetSettings) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "rx_max_pending":
+			z.RxMaxPending, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "RxMaxPending")
+				return
+			}
+		case "tx_max_pending":
+			z.TxMaxPending, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "TxMaxPending")
+				return
+			}
+		case "max_combined":
+			z.MaxCombined, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "MaxCombined")
+				return
+			}
+		case "rx_pending":
+			z.RxPending, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "RxPending")
+				return
+			}
+		case "tx_pending":
+			z.TxPending, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "TxPending")
+				return
+			}
+		case "combined_count":
+			z.CombinedCount, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "CombinedCount")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *NetSettings) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "rx_max_pending"
+	err = en.Append(0x86, 0xae, 0x72, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.RxMaxPending)
+	if err != nil {
+		err = msgp.WrapError(err, "RxMaxPending")
+		return
+	}
+	// write "tx_max_pending"
+	err = en.Append(0xae, 0x74, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.TxMaxPending)
+	if err != nil {
+		err = msgp.WrapError(err, "TxMaxPending")
+		return
+	}
+	// write "max_combined"
+	err = en.Append(0xac, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.MaxCombined)
+	if err != nil {
+		err = msgp.WrapError(err, "MaxCombined")
+		return
+	}
+	// write "rx_pending"
+	err = en.Append(0xaa, 0x72, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.RxPending)
+	if err != nil {
+		err = msgp.WrapError(err, "RxPending")
+		return
+	}
+	// write "tx_pending"
+	err = en.Append(0xaa, 0x74, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.TxPending)
+	if err != nil {
+		err = msgp.WrapError(err, "TxPending")
+		return
+	}
+	// write "combined_count"
+	err = en.Append(0xae, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.CombinedCount)
+	if err != nil {
+		err = msgp.WrapError(err, "CombinedCount")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *NetSettings) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "rx_max_pending"
+	o = append(o, 0x86, 0xae, 0x72, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67)
+	o = msgp.AppendUint32(o, z.RxMaxPending)
+	// string "tx_max_pending"
+	o = append(o, 0xae, 0x74, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67)
+	o = msgp.AppendUint32(o, z.TxMaxPending)
+	// string "max_combined"
+	o = append(o, 0xac, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64)
+	o = msgp.AppendUint32(o, z.MaxCombined)
+	// string "rx_pending"
+	o = append(o, 0xaa, 0x72, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67)
+	o = msgp.AppendUint32(o, z.RxPending)
+	// string "tx_pending"
+	o = append(o, 0xaa, 0x74, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67)
+	o = msgp.AppendUint32(o, z.TxPending)
+	// string "combined_count"
+	o = append(o, 0xae, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74)
+	o = msgp.AppendUint32(o, z.CombinedCount)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *NetSettings) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "rx_max_pending":
+			z.RxMaxPending, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RxMaxPending")
+				return
+			}
+		case "tx_max_pending":
+			z.TxMaxPending, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TxMaxPending")
+				return
+			}
+		case "max_combined":
+			z.MaxCombined, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxCombined")
+				return
+			}
+		case "rx_pending":
+			z.RxPending, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RxPending")
+				return
+			}
+		case "tx_pending":
+			z.TxPending, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TxPending")
+				return
+			}
+		case "combined_count":
+			z.CombinedCount, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CombinedCount")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *NetSettings) Msgsize() (s int) {
+	s = 1 + 15 + msgp.Uint32Size + 15 + msgp.Uint32Size + 13 + msgp.Uint32Size + 11 + msgp.Uint32Size + 11 + msgp.Uint32Size + 15 + msgp.Uint32Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Partition) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint16 /* 12 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "device":
+			z.Device, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Device")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "major":
+			z.Major, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "Major")
+				return
+			}
+		case "minor":
+			z.Minor, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "Minor")
+				return
+			}
+		case "model":
+			z.Model, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Model")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "revision":
+			z.Revision, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Revision")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "mountpoint":
+			z.Mountpoint, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Mountpoint")
+				return
+			}
+			zb0001Mask |= 0x10
+		case "fs_type":
+			z.FSType, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "FSType")
+				return
+			}
+			zb0001Mask |= 0x20
+		case "mount_options":
+			z.MountOptions, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "MountOptions")
+				return
+			}
+			zb0001Mask |= 0x40
+		case "mount_fs_type":
+			z.MountFSType, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "MountFSType")
+				return
+			}
+			zb0001Mask |= 0x80
+		case "space_total":
+			z.SpaceTotal, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "SpaceTotal")
+				return
+			}
+			zb0001Mask |= 0x100
+		case "space_free":
+			z.SpaceFree, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "SpaceFree")
+				return
+			}
+			zb0001Mask |= 0x200
+		case "inode_total":
+			z.InodeTotal, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "InodeTotal")
+				return
+			}
+			zb0001Mask |= 0x400
+		case "inode_free":
+			z.InodeFree, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "InodeFree")
+				return
+			}
+			zb0001Mask |= 0x800
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xfff {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Device = ""
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Model = ""
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Revision = ""
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.Mountpoint = ""
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.FSType = ""
+		}
+		if (zb0001Mask & 0x40) == 0 {
+			z.MountOptions = ""
+		}
+		if (zb0001Mask & 0x80) == 0 {
+			z.MountFSType = ""
+		}
+		if (zb0001Mask & 0x100) == 0 {
+			z.SpaceTotal = 0
+		}
+		if (zb0001Mask & 0x200) == 0 {
+			z.SpaceFree = 0
+		}
+		if (zb0001Mask & 0x400) == 0 {
+			z.InodeTotal = 0
+		}
+		if (zb0001Mask & 0x800) == 0 {
+			z.InodeFree = 0
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Partition) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(14)
+	var zb0001Mask uint16 /* 14 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Device == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Model == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Revision == "" {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.Mountpoint == "" {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if z.FSType == "" {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if z.MountOptions == "" {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if z.MountFSType == "" {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if z.SpaceTotal == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if z.SpaceFree == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x800
+	}
+	if z.InodeTotal == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
+	if z.InodeFree == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2000
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "error"
+			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Error)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "device"
+			err = en.Append(0xa6, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Device)
+			if err != nil {
+				err = msgp.WrapError(err, "Device")
+				return
+			}
+		}
+		// write "major"
+		err = en.Append(0xa5, 0x6d, 0x61, 0x6a, 0x6f, 0x72)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint32(z.Major)
+		if err != nil {
+			err = msgp.WrapError(err, "Major")
+			return
+		}
+		// write "minor"
+		err = en.Append(0xa5, 0x6d, 0x69, 0x6e, 0x6f, 0x72)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint32(z.Minor)
+		if err != nil {
+			err = msgp.WrapError(err, "Minor")
+			return
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// write "model"
+			err = en.Append(0xa5, 0x6d, 0x6f, 0x64, 0x65, 0x6c)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Model)
+			if err != nil {
+				err = msgp.WrapError(err, "Model")
+				return
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// write "revision"
+			err = en.Append(0xa8, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Revision)
+			if err != nil {
+				err = msgp.WrapError(err, "Revision")
+				return
+			}
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// write "mountpoint"
+			err = en.Append(0xaa, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Mountpoint)
+			if err != nil {
+				err = msgp.WrapError(err, "Mountpoint")
+				return
+			}
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// write "fs_type"
+			err = en.Append(0xa7, 0x66, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.FSType)
+			if err != nil {
+				err = msgp.WrapError(err, "FSType")
+				return
+			}
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// write "mount_options"
+			err = en.Append(0xad, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.MountOptions)
+			if err != nil {
+				err = msgp.WrapError(err, "MountOptions")
+				return
+			}
+		}
+		if (zb0001Mask & 0x200) == 0 { // if not omitted
+			// write "mount_fs_type"
+			err = en.Append(0xad, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.MountFSType)
+			if err != nil {
+				err = msgp.WrapError(err, "MountFSType")
+				return
+			}
+		}
+		if (zb0001Mask & 0x400) == 0 { // if not omitted
+			// write "space_total"
+			err = en.Append(0xab, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c)
+			if err != nil {
+				return
+			}
+			err = en.WriteUint64(z.SpaceTotal)
+			if err != nil {
+				err = msgp.WrapError(err, "SpaceTotal")
+				return
+			}
+		}
+		if (zb0001Mask & 0x800) == 0 { // if not omitted
+			// write "space_free"
+			err = en.Append(0xaa, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteUint64(z.SpaceFree)
+			if err != nil {
+				err = msgp.WrapError(err, "SpaceFree")
+				return
+			}
+		}
+		if (zb0001Mask & 0x1000) == 0 { // if not omitted
+			// write "inode_total"
+			err = en.Append(0xab, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c)
+			if err != nil {
+				return
+			}
+			err = en.WriteUint64(z.InodeTotal)
+			if err != nil {
+				err = msgp.WrapError(err, "InodeTotal")
+				return
+			}
+		}
+		if (zb0001Mask & 0x2000) == 0 { // if not omitted
+			// write "inode_free"
+			err = en.Append(0xaa, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteUint64(z.InodeFree)
+			if err != nil {
+				err = msgp.WrapError(err, "InodeFree")
+				return
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Partition) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(14)
+	var zb0001Mask uint16 /* 14 bits */
+	_ = zb0001Mask
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Device == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Model == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Revision == "" {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.Mountpoint == "" {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if z.FSType == "" {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if z.MountOptions == "" {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if z.MountFSType == "" {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if z.SpaceTotal == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if z.SpaceFree == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x800
+	}
+	if z.InodeTotal == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
+	if z.InodeFree == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2000
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "error"
+			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Error)
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "device"
+			o = append(o, 0xa6, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65)
+			o = msgp.AppendString(o, z.Device)
+		}
+		// string "major"
+		o = append(o, 0xa5, 0x6d, 0x61, 0x6a, 0x6f, 0x72)
+		o = msgp.AppendUint32(o, z.Major)
+		// string "minor"
+		o = append(o, 0xa5, 0x6d, 0x69, 0x6e, 0x6f, 0x72)
+		o = msgp.AppendUint32(o, z.Minor)
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// string "model"
+			o = append(o, 0xa5, 0x6d, 0x6f, 0x64, 0x65, 0x6c)
+			o = msgp.AppendString(o, z.Model)
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// string "revision"
+			o = append(o, 0xa8, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e)
+			o = msgp.AppendString(o, z.Revision)
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// string "mountpoint"
+			o = append(o, 0xaa, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+			o = msgp.AppendString(o, z.Mountpoint)
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// string "fs_type"
+			o = append(o, 0xa7, 0x66, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65)
+			o = msgp.AppendString(o, z.FSType)
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// string "mount_options"
+			o = append(o, 0xad, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+			o = msgp.AppendString(o, z.MountOptions)
+		}
+		if (zb0001Mask & 0x200) == 0 { // if not omitted
+			// string "mount_fs_type"
+			o = append(o, 0xad, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65)
+			o = msgp.AppendString(o, z.MountFSType)
+		}
+		if (zb0001Mask & 0x400) == 0 { // if not omitted
+			// string "space_total"
+			o = append(o, 0xab, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c)
+			o = msgp.AppendUint64(o, z.SpaceTotal)
+		}
+		if (zb0001Mask & 0x800) == 0 { // if not omitted
+			// string "space_free"
+			o = append(o, 0xaa, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x65)
+			o = msgp.AppendUint64(o, z.SpaceFree)
+		}
+		if (zb0001Mask & 0x1000) == 0 { // if not omitted
+			// string "inode_total"
+			o = append(o, 0xab, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c)
+			o = msgp.AppendUint64(o, z.InodeTotal)
+		}
+		if (zb0001Mask & 0x2000) == 0 { // if not omitted
+			// string "inode_free"
+			o = append(o, 0xaa, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x65)
+			o = msgp.AppendUint64(o, z.InodeFree)
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Partition) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint16 /* 12 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "device":
+			z.Device, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Device")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "major":
+			z.Major, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Major")
+				return
+			}
+		case "minor":
+			z.Minor, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Minor")
+				return
+			}
+		case "model":
+			z.Model, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Model")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "revision":
+			z.Revision, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Revision")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "mountpoint":
+			z.Mountpoint, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Mountpoint")
+				return
+			}
+			zb0001Mask |= 0x10
+		case "fs_type":
+			z.FSType, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FSType")
+				return
+			}
+			zb0001Mask |= 0x20
+		case "mount_options":
+			z.MountOptions, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MountOptions")
+				return
+			}
+			zb0001Mask |= 0x40
+		case "mount_fs_type":
+			z.MountFSType, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MountFSType")
+				return
+			}
+			zb0001Mask |= 0x80
+		case "space_total":
+			z.SpaceTotal, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SpaceTotal")
+				return
+			}
+			zb0001Mask |= 0x100
+		case "space_free":
+			z.SpaceFree, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SpaceFree")
+				return
+			}
+			zb0001Mask |= 0x200
+		case "inode_total":
+			z.InodeTotal, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "InodeTotal")
+				return
+			}
+			zb0001Mask |= 0x400
+		case "inode_free":
+			z.InodeFree, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "InodeFree")
+				return
+			}
+			zb0001Mask |= 0x800
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xfff {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Error = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Device = ""
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Model = ""
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Revision = ""
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.Mountpoint = ""
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.FSType = ""
+		}
+		if (zb0001Mask & 0x40) == 0 {
+			z.MountOptions = ""
+		}
+		if (zb0001Mask & 0x80) == 0 {
+			z.MountFSType = ""
+		}
+		if (zb0001Mask & 0x100) == 0 {
+			z.SpaceTotal = 0
+		}
+		if (zb0001Mask & 0x200) == 0 {
+			z.SpaceFree = 0
+		}
+		if (zb0001Mask & 0x400) == 0 {
+			z.InodeTotal = 0
+		}
+		if (zb0001Mask & 0x800) == 0 {
+			z.InodeFree = 0
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Partition) Msgsize() (s int) {
+	s = 1 + 6 + msgp.StringPrefixSize + len(z.Error) + 7 + msgp.StringPrefixSize + len(z.Device) + 6 + msgp.Uint32Size + 6 + msgp.Uint32Size + 6 + msgp.StringPrefixSize + len(z.Model) + 9 + msgp.StringPrefixSize + len(z.Revision) + 11 + msgp.StringPrefixSize + len(z.Mountpoint) + 8 + msgp.StringPrefixSize + len(z.FSType) + 14 + msgp.StringPrefixSize + len(z.MountOptions) + 14 + msgp.StringPrefixSize + len(z.MountFSType) + 12 + msgp.Uint64Size + 11 + msgp.Uint64Size + 12 + msgp.Uint64Size + 11 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Partitions) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "partitions":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Partitions")
+				return
+			}
+			if cap(z.Partitions) >= int(zb0002) {
+				z.Partitions = (z.Partitions)[:zb0002]
+			} else {
+				z.Partitions = make([]Partition, zb0002)
+			}
+			for za0001 := range z.Partitions {
+				err = z.Partitions[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Partitions", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Partitions = nil
+	}
+
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Partitions) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Partitions == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "partitions"
+			err = en.Append(0xaa, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Partitions)))
+			if err != nil {
+				err = msgp.WrapError(err, "Partitions")
+				return
+			}
+			for za0001 := range z.Partitions {
+				err = z.Partitions[za0001].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Partitions", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Partitions) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Partitions == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "partitions"
+			o = append(o, 0xaa, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Partitions)))
+			for za0001 := range z.Partitions {
+				o, err = z.Partitions[za0001].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Partitions", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Partitions) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "partitions":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Partitions")
+				return
+			}
+			if cap(z.Partitions) >= int(zb0002) {
+				z.Partitions = (z.Partitions)[:zb0002]
+			} else {
+				z.Partitions = make([]Partition, zb0002)
+			}
+			for za0001 := range z.Partitions {
+				bts, err = z.Partitions[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Partitions", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Partitions = nil
+	}
+
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Partitions) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 11 + msgp.ArrayHeaderSize
+	for za0001 := range z.Partitions {
+		s += z.Partitions[za0001].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ProductInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "family":
+			z.Family, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Family")
+				return
+			}
+		case "name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "vendor":
+			z.Vendor, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Vendor")
+				return
+			}
+		case "serial_number":
+			z.SerialNumber, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "SerialNumber")
+				return
+			}
+		case "uuid":
+			z.UUID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "UUID")
+				return
+			}
+		case "sku":
+			z.SKU, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "SKU")
+				return
+			}
+		case "version":
+			z.Version, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *ProductInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 8
+	// write "NodeCommon"
+	err = en.Append(0x88, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "NodeCommon")
+		return
+	}
+	// write "family"
+	err = en.Append(0xa6, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Family)
+	if err != nil {
+		err = msgp.WrapError(err, "Family")
+		return
+	}
+	// write "name"
+	err = en.Append(0xa4, 0x6e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "vendor"
+	err = en.Append(0xa6, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Vendor)
+	if err != nil {
+		err = msgp.WrapError(err, "Vendor")
+		return
+	}
+	// write "serial_number"
+	err = en.Append(0xad, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.SerialNumber)
+	if err != nil {
+		err = msgp.WrapError(err, "SerialNumber")
+		return
+	}
+	// write "uuid"
+	err = en.Append(0xa4, 0x75, 0x75, 0x69, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.UUID)
+	if err != nil {
+		err = msgp.WrapError(err, "UUID")
+		return
+	}
+	// write "sku"
+	err = en.Append(0xa3, 0x73, 0x6b, 0x75)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.SKU)
+	if err != nil {
+		err = msgp.WrapError(err, "SKU")
+		return
+	}
+	// write "version"
+	err = en.Append(0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Version)
+	if err != nil {
+		err = msgp.WrapError(err, "Version")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ProductInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 8
+	// string "NodeCommon"
+	o = append(o, 0x88, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+	o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "NodeCommon")
+		return
+	}
+	// string "family"
+	o = append(o, 0xa6, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79)
+	o = msgp.AppendString(o, z.Family)
+	// string "name"
+	o = append(o, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "vendor"
+	o = append(o, 0xa6, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72)
+	o = msgp.AppendString(o, z.Vendor)
+	// string "serial_number"
+	o = append(o, 0xad, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72)
+	o = msgp.AppendString(o, z.SerialNumber)
+	// string "uuid"
+	o = append(o, 0xa4, 0x75, 0x75, 0x69, 0x64)
+	o = msgp.AppendString(o, z.UUID)
+	// string "sku"
+	o = append(o, 0xa3, 0x73, 0x6b, 0x75)
+	o = msgp.AppendString(o, z.SKU)
+	// string "version"
+	o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.Version)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ProductInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "family":
+			z.Family, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Family")
+				return
+			}
+		case "name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "vendor":
+			z.Vendor, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Vendor")
+				return
+			}
+		case "serial_number":
+			z.SerialNumber, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SerialNumber")
+				return
+			}
+		case "uuid":
+			z.UUID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "UUID")
+				return
+			}
+		case "sku":
+			z.SKU, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SKU")
+				return
+			}
+		case "version":
+			z.Version, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ProductInfo) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 7 + msgp.StringPrefixSize + len(z.Family) + 5 + msgp.StringPrefixSize + len(z.Name) + 7 + msgp.StringPrefixSize + len(z.Vendor) + 14 + msgp.StringPrefixSize + len(z.SerialNumber) + 5 + msgp.StringPrefixSize + len(z.UUID) + 4 + msgp.StringPrefixSize + len(z.SKU) + 8 + msgp.StringPrefixSize + len(z.Version)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ReplDiagInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 string
+		zb0001, err = dc.ReadString()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z), err = replDiagInfoFromMsgp(zb0001)
+	}
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z ReplDiagInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	var zb0001 string
+	zb0001, err = replDiagInfoToMsgp(z)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	err = en.WriteString(zb0001)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z ReplDiagInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	var zb0001 string
+	zb0001, err = replDiagInfoToMsgp(z)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	o = msgp.AppendString(o, zb0001)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ReplDiagInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 string
+		zb0001, bts, err = msgp.ReadStringBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z), err = replDiagInfoFromMsgp(zb0001)
+
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z ReplDiagInfo) Msgsize() (s int) {
+	var zb0001 string
+	_ = z
+	s += msgp.StringPrefixSize + len(zb0001)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ServerInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint16 /* 12 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "state":
+			z.State, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "State")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "endpoint":
+			z.Endpoint, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Endpoint")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "uptime":
+			z.Uptime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Uptime")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "version":
+			z.Version, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "commitID":
+			z.CommitID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "CommitID")
+				return
+			}
+			zb0001Mask |= 0x10
+		case "network":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Network")
+				return
+			}
+			if z.Network == nil {
+				z.Network = make(map[string]string, zb0002)
+			} else if len(z.Network) > 0 {
+				for key := range z.Network {
+					delete(z.Network, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 string
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Network")
+					return
+				}
+				za0002, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Network", za0001)
+					return
+				}
+				z.Network[za0001] = za0002
+			}
+			zb0001Mask |= 0x20
+		case "drives":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Drives")
+				return
+			}
+			if cap(z.Drives) >= int(zb0003) {
+				z.Drives = (z.Drives)[:zb0003]
+			} else {
+				z.Drives = make([]Disk, zb0003)
+			}
+			for za0003 := range z.Drives {
+				err = z.Drives[za0003].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Drives", za0003)
+					return
+				}
+			}
+			zb0001Mask |= 0x40
+		case "poolNumber":
+			z.PoolNumber, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "PoolNumber")
+				return
+			}
+			zb0001Mask |= 0x80
+		case "poolNumbers":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "PoolNumbers")
+				return
+			}
+			if cap(z.PoolNumbers) >= int(zb0004) {
+				z.PoolNumbers = (z.PoolNumbers)[:zb0004]
+			} else {
+				z.PoolNumbers = make([]int, zb0004)
+			}
+			for za0004 := range z.PoolNumbers {
+				z.PoolNumbers[za0004], err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "PoolNumbers", za0004)
+					return
+				}
+			}
+			zb0001Mask |= 0x100
+		case "mem_stats":
+			err = z.MemStats.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "MemStats")
+				return
+			}
+		case "go_max_procs":
+			z.GoMaxProcs, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "GoMaxProcs")
+				return
+			}
+		case "num_cpu":
+			z.NumCPU, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "NumCPU")
+				return
+			}
+		case "runtime_version":
+			z.RuntimeVersion, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "RuntimeVersion")
+				return
+			}
+		case "gc_stats":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+				z.GCStats = nil
+			} else {
+				if z.GCStats == nil {
+					z.GCStats = new(GCStats)
+				}
+				err = z.GCStats.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+			}
+			zb0001Mask |= 0x200
+		case "minio_env_vars":
+			var zb0005 uint32
+			zb0005, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "MinioEnvVars")
+				return
+			}
+			if z.MinioEnvVars == nil {
+				z.MinioEnvVars = make(map[string]string, zb0005)
+			} else if len(z.MinioEnvVars) > 0 {
+				for key := range z.MinioEnvVars {
+					delete(z.MinioEnvVars, key)
+				}
+			}
+			for zb0005 > 0 {
+				zb0005--
+				var za0005 string
+				var za0006 string
+				za0005, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "MinioEnvVars")
+					return
+				}
+				za0006, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "MinioEnvVars", za0005)
+					return
+				}
+				z.MinioEnvVars[za0005] = za0006
+			}
+			zb0001Mask |= 0x400
+		case "edition":
+			z.Edition, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Edition")
+				return
+			}
+		case "license":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "License")
+					return
+				}
+				z.License = nil
+			} else {
+				if z.License == nil {
+					z.License = new(LicenseInfo)
+				}
+				err = z.License.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "License")
+					return
+				}
+			}
+			zb0001Mask |= 0x800
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xfff {
+		if (zb0001Mask & 0x1) == 0 {
+			z.State = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Endpoint = ""
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Uptime = 0
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Version = ""
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.CommitID = ""
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.Network = nil
+		}
+		if (zb0001Mask & 0x40) == 0 {
+			z.Drives = nil
+		}
+		if (zb0001Mask & 0x80) == 0 {
+			z.PoolNumber = 0
+		}
+		if (zb0001Mask & 0x100) == 0 {
+			z.PoolNumbers = nil
+		}
+		if (zb0001Mask & 0x200) == 0 {
+			z.GCStats = nil
+		}
+		if (zb0001Mask & 0x400) == 0 {
+			z.MinioEnvVars = nil
+		}
+		if (zb0001Mask & 0x800) == 0 {
+			z.License = nil
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *ServerInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(17)
+	var zb0001Mask uint32 /* 17 bits */
+	_ = zb0001Mask
+	if z.State == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Endpoint == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Uptime == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.Version == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.CommitID == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Network == nil {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.Drives == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if z.PoolNumber == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if z.PoolNumbers == nil {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if z.GCStats == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2000
+	}
+	if z.MinioEnvVars == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4000
+	}
+	if z.License == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10000
+	}
+	// variable map header, size zb0001Len
+	err = en.WriteMapHeader(zb0001Len)
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "state"
+			err = en.Append(0xa5, 0x73, 0x74, 0x61, 0x74, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.State)
+			if err != nil {
+				err = msgp.WrapError(err, "State")
+				return
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "endpoint"
+			err = en.Append(0xa8, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Endpoint)
+			if err != nil {
+				err = msgp.WrapError(err, "Endpoint")
+				return
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "uptime"
+			err = en.Append(0xa6, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt64(z.Uptime)
+			if err != nil {
+				err = msgp.WrapError(err, "Uptime")
+				return
+			}
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// write "version"
+			err = en.Append(0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Version)
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// write "commitID"
+			err = en.Append(0xa8, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.CommitID)
+			if err != nil {
+				err = msgp.WrapError(err, "CommitID")
+				return
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// write "network"
+			err = en.Append(0xa7, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.Network)))
+			if err != nil {
+				err = msgp.WrapError(err, "Network")
+				return
+			}
+			for za0001, za0002 := range z.Network {
+				err = en.WriteString(za0001)
+				if err != nil {
+					err = msgp.WrapError(err, "Network")
+					return
+				}
+				err = en.WriteString(za0002)
+				if err != nil {
+					err = msgp.WrapError(err, "Network", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// write "drives"
+			err = en.Append(0xa6, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Drives)))
+			if err != nil {
+				err = msgp.WrapError(err, "Drives")
+				return
+			}
+			for za0003 := range z.Drives {
+				err = z.Drives[za0003].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Drives", za0003)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// write "poolNumber"
+			err = en.Append(0xaa, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteInt(z.PoolNumber)
+			if err != nil {
+				err = msgp.WrapError(err, "PoolNumber")
+				return
+			}
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// write "poolNumbers"
+			err = en.Append(0xab, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.PoolNumbers)))
+			if err != nil {
+				err = msgp.WrapError(err, "PoolNumbers")
+				return
+			}
+			for za0004 := range z.PoolNumbers {
+				err = en.WriteInt(z.PoolNumbers[za0004])
+				if err != nil {
+					err = msgp.WrapError(err, "PoolNumbers", za0004)
+					return
+				}
+			}
+		}
+		// write "mem_stats"
+		err = en.Append(0xa9, 0x6d, 0x65, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
+		if err != nil {
+			return
+		}
+		err = z.MemStats.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "MemStats")
+			return
+		}
+		// write "go_max_procs"
+		err = en.Append(0xac, 0x67, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x73)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.GoMaxProcs)
+		if err != nil {
+			err = msgp.WrapError(err, "GoMaxProcs")
+			return
+		}
+		// write "num_cpu"
+		err = en.Append(0xa7, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x70, 0x75)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.NumCPU)
+		if err != nil {
+			err = msgp.WrapError(err, "NumCPU")
+			return
+		}
+		// write "runtime_version"
+		err = en.Append(0xaf, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.RuntimeVersion)
+		if err != nil {
+			err = msgp.WrapError(err, "RuntimeVersion")
+			return
+		}
+		if (zb0001Mask & 0x2000) == 0 { // if not omitted
+			// write "gc_stats"
+			err = en.Append(0xa8, 0x67, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
+			if err != nil {
+				return
+			}
+			if z.GCStats == nil {
+				err = en.WriteNil()
+				if err != nil {
+					return
+				}
+			} else {
+				err = z.GCStats.EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4000) == 0 { // if not omitted
+			// write "minio_env_vars"
+			err = en.Append(0xae, 0x6d, 0x69, 0x6e, 0x69, 0x6f, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.MinioEnvVars)))
+			if err != nil {
+				err = msgp.WrapError(err, "MinioEnvVars")
+				return
+			}
+			for za0005, za0006 := range z.MinioEnvVars {
+				err = en.WriteString(za0005)
+				if err != nil {
+					err = msgp.WrapError(err, "MinioEnvVars")
+					return
+				}
+				err = en.WriteString(za0006)
+				if err != nil {
+					err = msgp.WrapError(err, "MinioEnvVars", za0005)
+					return
+				}
+			}
+		}
+		// write "edition"
+		err = en.Append(0xa7, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Edition)
+		if err != nil {
+			err = msgp.WrapError(err, "Edition")
+			return
+		}
+		if (zb0001Mask & 0x10000) == 0 { // if not omitted
+			// write "license"
+			err = en.Append(0xa7, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65)
+			if err != nil {
+				return
+			}
+			if z.License == nil {
+				err = en.WriteNil()
+				if err != nil {
+					return
+				}
+			} else {
+				err = z.License.EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "License")
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ServerInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(17)
+	var zb0001Mask uint32 /* 17 bits */
+	_ = zb0001Mask
+	if z.State == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Endpoint == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Uptime == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.Version == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.CommitID == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Network == nil {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.Drives == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if z.PoolNumber == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if z.PoolNumbers == nil {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if z.GCStats == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2000
+	}
+	if z.MinioEnvVars == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4000
+	}
+	if z.License == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10000
+	}
+	// variable map header, size zb0001Len
+	o = msgp.AppendMapHeader(o, zb0001Len)
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "state"
+			o = append(o, 0xa5, 0x73, 0x74, 0x61, 0x74, 0x65)
+			o = msgp.AppendString(o, z.State)
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "endpoint"
+			o = append(o, 0xa8, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+			o = msgp.AppendString(o, z.Endpoint)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "uptime"
+			o = append(o, 0xa6, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65)
+			o = msgp.AppendInt64(o, z.Uptime)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// string "version"
+			o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+			o = msgp.AppendString(o, z.Version)
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// string "commitID"
+			o = append(o, 0xa8, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x44)
+			o = msgp.AppendString(o, z.CommitID)
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// string "network"
+			o = append(o, 0xa7, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b)
+			o = msgp.AppendMapHeader(o, uint32(len(z.Network)))
+			for za0001, za0002 := range z.Network {
+				o = msgp.AppendString(o, za0001)
+				o = msgp.AppendString(o, za0002)
+			}
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// string "drives"
+			o = append(o, 0xa6, 0x64, 0x72, 0x69, 0x76, 0x65, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Drives)))
+			for za0003 := range z.Drives {
+				o, err = z.Drives[za0003].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Drives", za0003)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// string "poolNumber"
+			o = append(o, 0xaa, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72)
+			o = msgp.AppendInt(o, z.PoolNumber)
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// string "poolNumbers"
+			o = append(o, 0xab, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.PoolNumbers)))
+			for za0004 := range z.PoolNumbers {
+				o = msgp.AppendInt(o, z.PoolNumbers[za0004])
+			}
+		}
+		// string "mem_stats"
+		o = append(o, 0xa9, 0x6d, 0x65, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
+		o, err = z.MemStats.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "MemStats")
+			return
+		}
+		// string "go_max_procs"
+		o = append(o, 0xac, 0x67, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x73)
+		o = msgp.AppendInt(o, z.GoMaxProcs)
+		// string "num_cpu"
+		o = append(o, 0xa7, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x70, 0x75)
+		o = msgp.AppendInt(o, z.NumCPU)
+		// string "runtime_version"
+		o = append(o, 0xaf, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+		o = msgp.AppendString(o, z.RuntimeVersion)
+		if (zb0001Mask & 0x2000) == 0 { // if not omitted
+			// string "gc_stats"
+			o = append(o, 0xa8, 0x67, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
+			if z.GCStats == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o, err = z.GCStats.MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4000) == 0 { // if not omitted
+			// string "minio_env_vars"
+			o = append(o, 0xae, 0x6d, 0x69, 0x6e, 0x69, 0x6f, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73)
+			o = msgp.AppendMapHeader(o, uint32(len(z.MinioEnvVars)))
+			for za0005, za0006 := range z.MinioEnvVars {
+				o = msgp.AppendString(o, za0005)
+				o = msgp.AppendString(o, za0006)
+			}
+		}
+		// string "edition"
+		o = append(o, 0xa7, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e)
+		o = msgp.AppendString(o, z.Edition)
+		if (zb0001Mask & 0x10000) == 0 { // if not omitted
+			// string "license"
+			o = append(o, 0xa7, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65)
+			if z.License == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o, err = z.License.MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "License")
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ServerInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint16 /* 12 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "state":
+			z.State, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "State")
+				return
+			}
+			zb0001Mask |= 0x1
+		case "endpoint":
+			z.Endpoint, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Endpoint")
+				return
+			}
+			zb0001Mask |= 0x2
+		case "uptime":
+			z.Uptime, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Uptime")
+				return
+			}
+			zb0001Mask |= 0x4
+		case "version":
+			z.Version, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Version")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "commitID":
+			z.CommitID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CommitID")
+				return
+			}
+			zb0001Mask |= 0x10
+		case "network":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Network")
+				return
+			}
+			if z.Network == nil {
+				z.Network = make(map[string]string, zb0002)
+			} else if len(z.Network) > 0 {
+				for key := range z.Network {
+					delete(z.Network, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 string
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Network")
+					return
+				}
+				za0002, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Network", za0001)
+					return
+				}
+				z.Network[za0001] = za0002
+			}
+			zb0001Mask |= 0x20
+		case "drives":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Drives")
+				return
+			}
+			if cap(z.Drives) >= int(zb0003) {
+				z.Drives = (z.Drives)[:zb0003]
+			} else {
+				z.Drives = make([]Disk, zb0003)
+			}
+			for za0003 := range z.Drives {
+				bts, err = z.Drives[za0003].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Drives", za0003)
+					return
+				}
+			}
+			zb0001Mask |= 0x40
+		case "poolNumber":
+			z.PoolNumber, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PoolNumber")
+				return
+			}
+			zb0001Mask |= 0x80
+		case "poolNumbers":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PoolNumbers")
+				return
+			}
+			if cap(z.PoolNumbers) >= int(zb0004) {
+				z.PoolNumbers = (z.PoolNumbers)[:zb0004]
+			} else {
+				z.PoolNumbers = make([]int, zb0004)
+			}
+			for za0004 := range z.PoolNumbers {
+				z.PoolNumbers[za0004], bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "PoolNumbers", za0004)
+					return
+				}
+			}
+			zb0001Mask |= 0x100
+		case "mem_stats":
+			bts, err = z.MemStats.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MemStats")
+				return
+			}
+		case "go_max_procs":
+			z.GoMaxProcs, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "GoMaxProcs")
+				return
+			}
+		case "num_cpu":
+			z.NumCPU, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumCPU")
+				return
+			}
+		case "runtime_version":
+			z.RuntimeVersion, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RuntimeVersion")
+				return
+			}
+		case "gc_stats":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.GCStats = nil
+			} else {
+				if z.GCStats == nil {
+					z.GCStats = new(GCStats)
+				}
+				bts, err = z.GCStats.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+			}
+			zb0001Mask |= 0x200
+		case "minio_env_vars":
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinioEnvVars")
+				return
+			}
+			if z.MinioEnvVars == nil {
+				z.MinioEnvVars = make(map[string]string, zb0005)
+			} else if len(z.MinioEnvVars) > 0 {
+				for key := range z.MinioEnvVars {
+					delete(z.MinioEnvVars, key)
+				}
+			}
+			for zb0005 > 0 {
+				var za0005 string
+				var za0006 string
+				zb0005--
+				za0005, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "MinioEnvVars")
+					return
+				}
+				za0006, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "MinioEnvVars", za0005)
+					return
+				}
+				z.MinioEnvVars[za0005] = za0006
+			}
+			zb0001Mask |= 0x400
+		case "edition":
+			z.Edition, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Edition")
+				return
+			}
+		case "license":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.License = nil
+			} else {
+				if z.License == nil {
+					z.License = new(LicenseInfo)
+				}
+				bts, err = z.License.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "License")
+					return
+				}
+			}
+			zb0001Mask |= 0x800
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0xfff {
+		if (zb0001Mask & 0x1) == 0 {
+			z.State = ""
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Endpoint = ""
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.Uptime = 0
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.Version = ""
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.CommitID = ""
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.Network = nil
+		}
+		if (zb0001Mask & 0x40) == 0 {
+			z.Drives = nil
+		}
+		if (zb0001Mask & 0x80) == 0 {
+			z.PoolNumber = 0
+		}
+		if (zb0001Mask & 0x100) == 0 {
+			z.PoolNumbers = nil
+		}
+		if (zb0001Mask & 0x200) == 0 {
+			z.GCStats = nil
+		}
+		if (zb0001Mask & 0x400) == 0 {
+			z.MinioEnvVars = nil
+		}
+		if (zb0001Mask & 0x800) == 0 {
+			z.License = nil
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ServerInfo) Msgsize() (s int) {
+	s = 3 + 6 + msgp.StringPrefixSize + len(z.State) + 9 + msgp.StringPrefixSize + len(z.Endpoint) + 7 + msgp.Int64Size + 8 + msgp.StringPrefixSize + len(z.Version) + 9 + msgp.StringPrefixSize + len(z.CommitID) + 8 + msgp.MapHeaderSize
+	if z.Network != nil {
+		for za0001, za0002 := range z.Network {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
+	s += 7 + msgp.ArrayHeaderSize
+	for za0003 := range z.Drives {
+		s += z.Drives[za0003].Msgsize()
+	}
+	s += 11 + msgp.IntSize + 12 + msgp.ArrayHeaderSize + (len(z.PoolNumbers) * (msgp.IntSize)) + 10 + z.MemStats.Msgsize() + 13 + msgp.IntSize + 8 + msgp.IntSize + 16 + msgp.StringPrefixSize + len(z.RuntimeVersion) + 9
+	if z.GCStats == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.GCStats.Msgsize()
+	}
+	s += 15 + msgp.MapHeaderSize
+	if z.MinioEnvVars != nil {
+		for za0005, za0006 := range z.MinioEnvVars {
+			_ = za0006
+			s += msgp.StringPrefixSize + len(za0005) + msgp.StringPrefixSize + len(za0006)
+		}
+	}
+	s += 8 + msgp.StringPrefixSize + len(z.Edition) + 8
+	if z.License == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.License.Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SysConfig) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "config":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Config")
+				return
+			}
+			if z.Config == nil {
+				z.Config = make(map[string]interface{}, zb0002)
+			} else if len(z.Config) > 0 {
+				for key := range z.Config {
+					delete(z.Config, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 interface{}
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Config")
+					return
+				}
+				za0002, err = dc.ReadIntf()
+				if err != nil {
+					err = msgp.WrapError(err, "Config", za0001)
+					return
+				}
+				z.Config[za0001] = za0002
+			}
+			zb0001Mask |= 0x1
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Config = nil
+	}
+
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *SysConfig) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Config == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "config"
+			err = en.Append(0xa6, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.Config)))
+			if err != nil {
+				err = msgp.WrapError(err, "Config")
+				return
+			}
+			for za0001, za0002 := range z.Config {
+				err = en.WriteString(za0001)
+				if err != nil {
+					err = msgp.WrapError(err, "Config")
+					return
+				}
+				err = en.WriteIntf(za0002)
+				if err != nil {
+					err = msgp.WrapError(err, "Config", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SysConfig) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Config == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "config"
+			o = append(o, 0xa6, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67)
+			o = msgp.AppendMapHeader(o, uint32(len(z.Config)))
+			for za0001, za0002 := range z.Config {
+				o = msgp.AppendString(o, za0001)
+				o, err = msgp.AppendIntf(o, za0002)
+				if err != nil {
+					err = msgp.WrapError(err, "Config", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SysConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "config":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Config")
+				return
+			}
+			if z.Config == nil {
+				z.Config = make(map[string]interface{}, zb0002)
+			} else if len(z.Config) > 0 {
+				for key := range z.Config {
+					delete(z.Config, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 interface{}
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Config")
+					return
+				}
+				za0002, bts, err = msgp.ReadIntfBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Config", za0001)
+					return
+				}
+				z.Config[za0001] = za0002
+			}
+			zb0001Mask |= 0x1
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Config = nil
+	}
+
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SysConfig) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 7 + msgp.MapHeaderSize
+	if z.Config != nil {
+		for za0001, za0002 := range z.Config {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.GuessSize(za0002)
+		}
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SysErrors) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "errors":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Errors")
+				return
+			}
+			if cap(z.Errors) >= int(zb0002) {
+				z.Errors = (z.Errors)[:zb0002]
+			} else {
+				z.Errors = make([]string, zb0002)
+			}
+			for za0001 := range z.Errors {
+				z.Errors[za0001], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Errors", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Errors = nil
+	}
+
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *SysErrors) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Errors == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "errors"
+			err = en.Append(0xa6, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Errors)))
+			if err != nil {
+				err = msgp.WrapError(err, "Errors")
+				return
+			}
+			for za0001 := range z.Errors {
+				err = en.WriteString(z.Errors[za0001])
+				if err != nil {
+					err = msgp.WrapError(err, "Errors", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SysErrors) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Errors == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "errors"
+			o = append(o, 0xa6, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Errors)))
+			for za0001 := range z.Errors {
+				o = msgp.AppendString(o, z.Errors[za0001])
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SysErrors) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "errors":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Errors")
+				return
+			}
+			if cap(z.Errors) >= int(zb0002) {
+				z.Errors = (z.Errors)[:zb0002]
+			} else {
+				z.Errors = make([]string, zb0002)
+			}
+			for za0001 := range z.Errors {
+				z.Errors[za0001], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Errors", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Errors = nil
+	}
+
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SysErrors) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 7 + msgp.ArrayHeaderSize
+	for za0001 := range z.Errors {
+		s += msgp.StringPrefixSize + len(z.Errors[za0001])
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SysInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint16 /* 10 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "cpus":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "CPUInfo")
+				return
+			}
+			if cap(z.CPUInfo) >= int(zb0002) {
+				z.CPUInfo = (z.CPUInfo)[:zb0002]
+			} else {
+				z.CPUInfo = make([]CPUs, zb0002)
+			}
+			for za0001 := range z.CPUInfo {
+				err = z.CPUInfo[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUInfo", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		case "partitions":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Partitions")
+				return
+			}
+			if cap(z.Partitions) >= int(zb0003) {
+				z.Partitions = (z.Partitions)[:zb0003]
+			} else {
+				z.Partitions = make([]Partitions, zb0003)
+			}
+			for za0002 := range z.Partitions {
+				err = z.Partitions[za0002].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Partitions", za0002)
+					return
+				}
+			}
+			zb0001Mask |= 0x2
+		case "osinfo":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "OSInfo")
+				return
+			}
+			if cap(z.OSInfo) >= int(zb0004) {
+				z.OSInfo = (z.OSInfo)[:zb0004]
+			} else {
+				z.OSInfo = make([]OSInfo, zb0004)
+			}
+			for za0003 := range z.OSInfo {
+				err = z.OSInfo[za0003].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "OSInfo", za0003)
+					return
+				}
+			}
+			zb0001Mask |= 0x4
+		case "meminfo":
+			var zb0005 uint32
+			zb0005, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "MemInfo")
+				return
+			}
+			if cap(z.MemInfo) >= int(zb0005) {
+				z.MemInfo = (z.MemInfo)[:zb0005]
+			} else {
+				z.MemInfo = make([]MemInfo, zb0005)
+			}
+			for za0004 := range z.MemInfo {
+				err = z.MemInfo[za0004].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "MemInfo", za0004)
+					return
+				}
+			}
+			zb0001Mask |= 0x8
+		case "procinfo":
+			var zb0006 uint32
+			zb0006, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ProcInfo")
+				return
+			}
+			if cap(z.ProcInfo) >= int(zb0006) {
+				z.ProcInfo = (z.ProcInfo)[:zb0006]
+			} else {
+				z.ProcInfo = make([]ProcInfo, zb0006)
+			}
+			for za0005 := range z.ProcInfo {
+				err = z.ProcInfo[za0005].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "ProcInfo", za0005)
+					return
+				}
+			}
+			zb0001Mask |= 0x10
+		case "netinfo":
+			var zb0007 uint32
+			zb0007, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "NetInfo")
+				return
+			}
+			if cap(z.NetInfo) >= int(zb0007) {
+				z.NetInfo = (z.NetInfo)[:zb0007]
+			} else {
+				z.NetInfo = make([]NetInfo, zb0007)
+			}
+			for za0006 := range z.NetInfo {
+				err = z.NetInfo[za0006].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "NetInfo", za0006)
+					return
+				}
+			}
+			zb0001Mask |= 0x20
+		case "errors":
+			var zb0008 uint32
+			zb0008, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "SysErrs")
+				return
+			}
+			if cap(z.SysErrs) >= int(zb0008) {
+				z.SysErrs = (z.SysErrs)[:zb0008]
+			} else {
+				z.SysErrs = make([]SysErrors, zb0008)
+			}
+			for za0007 := range z.SysErrs {
+				err = z.SysErrs[za0007].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "SysErrs", za0007)
+					return
+				}
+			}
+			zb0001Mask |= 0x40
+		case "services":
+			var zb0009 uint32
+			zb0009, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "SysServices")
+				return
+			}
+			if cap(z.SysServices) >= int(zb0009) {
+				z.SysServices = (z.SysServices)[:zb0009]
+			} else {
+				z.SysServices = make([]SysServices, zb0009)
+			}
+			for za0008 := range z.SysServices {
+				err = z.SysServices[za0008].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "SysServices", za0008)
+					return
+				}
+			}
+			zb0001Mask |= 0x80
+		case "config":
+			var zb0010 uint32
+			zb0010, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "SysConfig")
+				return
+			}
+			if cap(z.SysConfig) >= int(zb0010) {
+				z.SysConfig = (z.SysConfig)[:zb0010]
+			} else {
+				z.SysConfig = make([]SysConfig, zb0010)
+			}
+			for za0009 := range z.SysConfig {
+				err = z.SysConfig[za0009].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "SysConfig", za0009)
+					return
+				}
+			}
+			zb0001Mask |= 0x100
+		case "productinfo":
+			var zb0011 uint32
+			zb0011, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ProductInfo")
+				return
+			}
+			if cap(z.ProductInfo) >= int(zb0011) {
+				z.ProductInfo = (z.ProductInfo)[:zb0011]
+			} else {
+				z.ProductInfo = make([]ProductInfo, zb0011)
+			}
+			for za0010 := range z.ProductInfo {
+				err = z.ProductInfo[za0010].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "ProductInfo", za0010)
+					return
+				}
+			}
+			zb0001Mask |= 0x200
+		case "kubernetes":
+			err = z.KubernetesInfo.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "KubernetesInfo")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3ff {
+		if (zb0001Mask & 0x1) == 0 {
+			z.CPUInfo = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Partitions = nil
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.OSInfo = nil
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.MemInfo = nil
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.ProcInfo = nil
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.NetInfo = nil
+		}
+		if (zb0001Mask & 0x40) == 0 {
+			z.SysErrs = nil
+		}
+		if (zb0001Mask & 0x80) == 0 {
+			z.SysServices = nil
+		}
+		if (zb0001Mask & 0x100) == 0 {
+			z.SysConfig = nil
+		}
+		if (zb0001Mask & 0x200) == 0 {
+			z.ProductInfo = nil
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *SysInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(11)
+	var zb0001Mask uint16 /* 11 bits */
+	_ = zb0001Mask
+	if z.CPUInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Partitions == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.OSInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.MemInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.ProcInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.NetInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.SysErrs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if z.SysServices == nil {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if z.SysConfig == nil {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if z.ProductInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "cpus"
+			err = en.Append(0xa4, 0x63, 0x70, 0x75, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.CPUInfo)))
+			if err != nil {
+				err = msgp.WrapError(err, "CPUInfo")
+				return
+			}
+			for za0001 := range z.CPUInfo {
+				err = z.CPUInfo[za0001].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUInfo", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "partitions"
+			err = en.Append(0xaa, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Partitions)))
+			if err != nil {
+				err = msgp.WrapError(err, "Partitions")
+				return
+			}
+			for za0002 := range z.Partitions {
+				err = z.Partitions[za0002].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Partitions", za0002)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// write "osinfo"
+			err = en.Append(0xa6, 0x6f, 0x73, 0x69, 0x6e, 0x66, 0x6f)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.OSInfo)))
+			if err != nil {
+				err = msgp.WrapError(err, "OSInfo")
+				return
+			}
+			for za0003 := range z.OSInfo {
+				err = z.OSInfo[za0003].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "OSInfo", za0003)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// write "meminfo"
+			err = en.Append(0xa7, 0x6d, 0x65, 0x6d, 0x69, 0x6e, 0x66, 0x6f)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.MemInfo)))
+			if err != nil {
+				err = msgp.WrapError(err, "MemInfo")
+				return
+			}
+			for za0004 := range z.MemInfo {
+				err = z.MemInfo[za0004].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "MemInfo", za0004)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// write "procinfo"
+			err = en.Append(0xa8, 0x70, 0x72, 0x6f, 0x63, 0x69, 0x6e, 0x66, 0x6f)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.ProcInfo)))
+			if err != nil {
+				err = msgp.WrapError(err, "ProcInfo")
+				return
+			}
+			for za0005 := range z.ProcInfo {
+				err = z.ProcInfo[za0005].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "ProcInfo", za0005)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// write "netinfo"
+			err = en.Append(0xa7, 0x6e, 0x65, 0x74, 0x69, 0x6e, 0x66, 0x6f)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.NetInfo)))
+			if err != nil {
+				err = msgp.WrapError(err, "NetInfo")
+				return
+			}
+			for za0006 := range z.NetInfo {
+				err = z.NetInfo[za0006].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "NetInfo", za0006)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// write "errors"
+			err = en.Append(0xa6, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.SysErrs)))
+			if err != nil {
+				err = msgp.WrapError(err, "SysErrs")
+				return
+			}
+			for za0007 := range z.SysErrs {
+				err = z.SysErrs[za0007].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "SysErrs", za0007)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// write "services"
+			err = en.Append(0xa8, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.SysServices)))
+			if err != nil {
+				err = msgp.WrapError(err, "SysServices")
+				return
+			}
+			for za0008 := range z.SysServices {
+				err = z.SysServices[za0008].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "SysServices", za0008)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// write "config"
+			err = en.Append(0xa6, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.SysConfig)))
+			if err != nil {
+				err = msgp.WrapError(err, "SysConfig")
+				return
+			}
+			for za0009 := range z.SysConfig {
+				err = z.SysConfig[za0009].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "SysConfig", za0009)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x200) == 0 { // if not omitted
+			// write "productinfo"
+			err = en.Append(0xab, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6e, 0x66, 0x6f)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.ProductInfo)))
+			if err != nil {
+				err = msgp.WrapError(err, "ProductInfo")
+				return
+			}
+			for za0010 := range z.ProductInfo {
+				err = z.ProductInfo[za0010].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "ProductInfo", za0010)
+					return
+				}
+			}
+		}
+		// write "kubernetes"
+		err = en.Append(0xaa, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73)
+		if err != nil {
+			return
+		}
+		err = z.KubernetesInfo.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "KubernetesInfo")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SysInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(11)
+	var zb0001Mask uint16 /* 11 bits */
+	_ = zb0001Mask
+	if z.CPUInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Partitions == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.OSInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if z.MemInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.ProcInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.NetInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.SysErrs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if z.SysServices == nil {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if z.SysConfig == nil {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if z.ProductInfo == nil {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "cpus"
+			o = append(o, 0xa4, 0x63, 0x70, 0x75, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.CPUInfo)))
+			for za0001 := range z.CPUInfo {
+				o, err = z.CPUInfo[za0001].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUInfo", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "partitions"
+			o = append(o, 0xaa, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Partitions)))
+			for za0002 := range z.Partitions {
+				o, err = z.Partitions[za0002].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Partitions", za0002)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
+			// string "osinfo"
+			o = append(o, 0xa6, 0x6f, 0x73, 0x69, 0x6e, 0x66, 0x6f)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.OSInfo)))
+			for za0003 := range z.OSInfo {
+				o, err = z.OSInfo[za0003].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "OSInfo", za0003)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
+			// string "meminfo"
+			o = append(o, 0xa7, 0x6d, 0x65, 0x6d, 0x69, 0x6e, 0x66, 0x6f)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.MemInfo)))
+			for za0004 := range z.MemInfo {
+				o, err = z.MemInfo[za0004].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "MemInfo", za0004)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// string "procinfo"
+			o = append(o, 0xa8, 0x70, 0x72, 0x6f, 0x63, 0x69, 0x6e, 0x66, 0x6f)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.ProcInfo)))
+			for za0005 := range z.ProcInfo {
+				o, err = z.ProcInfo[za0005].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "ProcInfo", za0005)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// string "netinfo"
+			o = append(o, 0xa7, 0x6e, 0x65, 0x74, 0x69, 0x6e, 0x66, 0x6f)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.NetInfo)))
+			for za0006 := range z.NetInfo {
+				o, err = z.NetInfo[za0006].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "NetInfo", za0006)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not omitted
+			// string "errors"
+			o = append(o, 0xa6, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.SysErrs)))
+			for za0007 := range z.SysErrs {
+				o, err = z.SysErrs[za0007].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "SysErrs", za0007)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not omitted
+			// string "services"
+			o = append(o, 0xa8, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.SysServices)))
+			for za0008 := range z.SysServices {
+				o, err = z.SysServices[za0008].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "SysServices", za0008)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not omitted
+			// string "config"
+			o = append(o, 0xa6, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.SysConfig)))
+			for za0009 := range z.SysConfig {
+				o, err = z.SysConfig[za0009].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "SysConfig", za0009)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x200) == 0 { // if not omitted
+			// string "productinfo"
+			o = append(o, 0xab, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6e, 0x66, 0x6f)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.ProductInfo)))
+			for za0010 := range z.ProductInfo {
+				o, err = z.ProductInfo[za0010].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "ProductInfo", za0010)
+					return
+				}
+			}
+		}
+		// string "kubernetes"
+		o = append(o, 0xaa, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73)
+		o, err = z.KubernetesInfo.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "KubernetesInfo")
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SysInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint16 /* 10 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "cpus":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CPUInfo")
+				return
+			}
+			if cap(z.CPUInfo) >= int(zb0002) {
+				z.CPUInfo = (z.CPUInfo)[:zb0002]
+			} else {
+				z.CPUInfo = make([]CPUs, zb0002)
+			}
+			for za0001 := range z.CPUInfo {
+				bts, err = z.CPUInfo[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CPUInfo", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		case "partitions":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Partitions")
+				return
+			}
+			if cap(z.Partitions) >= int(zb0003) {
+				z.Partitions = (z.Partitions)[:zb0003]
+			} else {
+				z.Partitions = make([]Partitions, zb0003)
+			}
+			for za0002 := range z.Partitions {
+				bts, err = z.Partitions[za0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Partitions", za0002)
+					return
+				}
+			}
+			zb0001Mask |= 0x2
+		case "osinfo":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "OSInfo")
+				return
+			}
+			if cap(z.OSInfo) >= int(zb0004) {
+				z.OSInfo = (z.OSInfo)[:zb0004]
+			} else {
+				z.OSInfo = make([]OSInfo, zb0004)
+			}
+			for za0003 := range z.OSInfo {
+				bts, err = z.OSInfo[za0003].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "OSInfo", za0003)
+					return
+				}
+			}
+			zb0001Mask |= 0x4
+		case "meminfo":
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MemInfo")
+				return
+			}
+			if cap(z.MemInfo) >= int(zb0005) {
+				z.MemInfo = (z.MemInfo)[:zb0005]
+			} else {
+				z.MemInfo = make([]MemInfo, zb0005)
+			}
+			for za0004 := range z.MemInfo {
+				bts, err = z.MemInfo[za0004].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "MemInfo", za0004)
+					return
+				}
+			}
+			zb0001Mask |= 0x8
+		case "procinfo":
+			var zb0006 uint32
+			zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ProcInfo")
+				return
+			}
+			if cap(z.ProcInfo) >= int(zb0006) {
+				z.ProcInfo = (z.ProcInfo)[:zb0006]
+			} else {
+				z.ProcInfo = make([]ProcInfo, zb0006)
+			}
+			for za0005 := range z.ProcInfo {
+				bts, err = z.ProcInfo[za0005].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ProcInfo", za0005)
+					return
+				}
+			}
+			zb0001Mask |= 0x10
+		case "netinfo":
+			var zb0007 uint32
+			zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NetInfo")
+				return
+			}
+			if cap(z.NetInfo) >= int(zb0007) {
+				z.NetInfo = (z.NetInfo)[:zb0007]
+			} else {
+				z.NetInfo = make([]NetInfo, zb0007)
+			}
+			for za0006 := range z.NetInfo {
+				bts, err = z.NetInfo[za0006].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "NetInfo", za0006)
+					return
+				}
+			}
+			zb0001Mask |= 0x20
+		case "errors":
+			var zb0008 uint32
+			zb0008, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SysErrs")
+				return
+			}
+			if cap(z.SysErrs) >= int(zb0008) {
+				z.SysErrs = (z.SysErrs)[:zb0008]
+			} else {
+				z.SysErrs = make([]SysErrors, zb0008)
+			}
+			for za0007 := range z.SysErrs {
+				bts, err = z.SysErrs[za0007].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "SysErrs", za0007)
+					return
+				}
+			}
+			zb0001Mask |= 0x40
+		case "services":
+			var zb0009 uint32
+			zb0009, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SysServices")
+				return
+			}
+			if cap(z.SysServices) >= int(zb0009) {
+				z.SysServices = (z.SysServices)[:zb0009]
+			} else {
+				z.SysServices = make([]SysServices, zb0009)
+			}
+			for za0008 := range z.SysServices {
+				bts, err = z.SysServices[za0008].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "SysServices", za0008)
+					return
+				}
+			}
+			zb0001Mask |= 0x80
+		case "config":
+			var zb0010 uint32
+			zb0010, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SysConfig")
+				return
+			}
+			if cap(z.SysConfig) >= int(zb0010) {
+				z.SysConfig = (z.SysConfig)[:zb0010]
+			} else {
+				z.SysConfig = make([]SysConfig, zb0010)
+			}
+			for za0009 := range z.SysConfig {
+				bts, err = z.SysConfig[za0009].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "SysConfig", za0009)
+					return
+				}
+			}
+			zb0001Mask |= 0x100
+		case "productinfo":
+			var zb0011 uint32
+			zb0011, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ProductInfo")
+				return
+			}
+			if cap(z.ProductInfo) >= int(zb0011) {
+				z.ProductInfo = (z.ProductInfo)[:zb0011]
+			} else {
+				z.ProductInfo = make([]ProductInfo, zb0011)
+			}
+			for za0010 := range z.ProductInfo {
+				bts, err = z.ProductInfo[za0010].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ProductInfo", za0010)
+					return
+				}
+			}
+			zb0001Mask |= 0x200
+		case "kubernetes":
+			bts, err = z.KubernetesInfo.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "KubernetesInfo")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3ff {
+		if (zb0001Mask & 0x1) == 0 {
+			z.CPUInfo = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Partitions = nil
+		}
+		if (zb0001Mask & 0x4) == 0 {
+			z.OSInfo = nil
+		}
+		if (zb0001Mask & 0x8) == 0 {
+			z.MemInfo = nil
+		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.ProcInfo = nil
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.NetInfo = nil
+		}
+		if (zb0001Mask & 0x40) == 0 {
+			z.SysErrs = nil
+		}
+		if (zb0001Mask & 0x80) == 0 {
+			z.SysServices = nil
+		}
+		if (zb0001Mask & 0x100) == 0 {
+			z.SysConfig = nil
+		}
+		if (zb0001Mask & 0x200) == 0 {
+			z.ProductInfo = nil
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SysInfo) Msgsize() (s int) {
+	s = 1 + 5 + msgp.ArrayHeaderSize
+	for za0001 := range z.CPUInfo {
+		s += z.CPUInfo[za0001].Msgsize()
+	}
+	s += 11 + msgp.ArrayHeaderSize
+	for za0002 := range z.Partitions {
+		s += z.Partitions[za0002].Msgsize()
+	}
+	s += 7 + msgp.ArrayHeaderSize
+	for za0003 := range z.OSInfo {
+		s += z.OSInfo[za0003].Msgsize()
+	}
+	s += 8 + msgp.ArrayHeaderSize
+	for za0004 := range z.MemInfo {
+		s += z.MemInfo[za0004].Msgsize()
+	}
+	s += 9 + msgp.ArrayHeaderSize
+	for za0005 := range z.ProcInfo {
+		s += z.ProcInfo[za0005].Msgsize()
+	}
+	s += 8 + msgp.ArrayHeaderSize
+	for za0006 := range z.NetInfo {
+		s += z.NetInfo[za0006].Msgsize()
+	}
+	s += 7 + msgp.ArrayHeaderSize
+	for za0007 := range z.SysErrs {
+		s += z.SysErrs[za0007].Msgsize()
+	}
+	s += 9 + msgp.ArrayHeaderSize
+	for za0008 := range z.SysServices {
+		s += z.SysServices[za0008].Msgsize()
+	}
+	s += 7 + msgp.ArrayHeaderSize
+	for za0009 := range z.SysConfig {
+		s += z.SysConfig[za0009].Msgsize()
+	}
+	s += 12 + msgp.ArrayHeaderSize
+	for za0010 := range z.ProductInfo {
+		s += z.ProductInfo[za0010].Msgsize()
+	}
+	s += 11 + z.KubernetesInfo.Msgsize()
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SysService) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "status":
+			z.Status, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z SysService) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "name"
+	err = en.Append(0x82, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "status"
+	err = en.Append(0xa6, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Status)
+	if err != nil {
+		err = msgp.WrapError(err, "Status")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z SysService) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "name"
+	o = append(o, 0x82, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "status"
+	o = append(o, 0xa6, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73)
+	o = msgp.AppendString(o, z.Status)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SysService) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "status":
+			z.Status, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z SysService) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 7 + msgp.StringPrefixSize + len(z.Status)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SysServices) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			err = (*nodeCommon)(&z.NodeCommon).DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "services":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Services")
+				return
+			}
+			if cap(z.Services) >= int(zb0002) {
+				z.Services = (z.Services)[:zb0002]
+			} else {
+				z.Services = make([]SysService, zb0002)
+			}
+			for za0001 := range z.Services {
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Services", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "Services", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "name":
+						z.Services[za0001].Name, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Services", za0001, "Name")
+							return
+						}
+					case "status":
+						z.Services[za0001].Status, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Services", za0001, "Status")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Services", za0001)
+							return
+						}
+					}
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Services = nil
+	}
+
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *SysServices) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Services == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "NodeCommon"
+		err = en.Append(0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		if err != nil {
+			return
+		}
+		err = (*nodeCommon)(&z.NodeCommon).EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "services"
+			err = en.Append(0xa8, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Services)))
+			if err != nil {
+				err = msgp.WrapError(err, "Services")
+				return
+			}
+			for za0001 := range z.Services {
+				// map header, size 2
+				// write "name"
+				err = en.Append(0x82, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+				if err != nil {
+					return
+				}
+				err = en.WriteString(z.Services[za0001].Name)
+				if err != nil {
+					err = msgp.WrapError(err, "Services", za0001, "Name")
+					return
+				}
+				// write "status"
+				err = en.Append(0xa6, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73)
+				if err != nil {
+					return
+				}
+				err = en.WriteString(z.Services[za0001].Status)
+				if err != nil {
+					err = msgp.WrapError(err, "Services", za0001, "Status")
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SysServices) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Services == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "NodeCommon"
+		o = append(o, 0xaa, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e)
+		o, err = (*nodeCommon)(&z.NodeCommon).MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "NodeCommon")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "services"
+			o = append(o, 0xa8, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Services)))
+			for za0001 := range z.Services {
+				// map header, size 2
+				// string "name"
+				o = append(o, 0x82, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+				o = msgp.AppendString(o, z.Services[za0001].Name)
+				// string "status"
+				o = append(o, 0xa6, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73)
+				o = msgp.AppendString(o, z.Services[za0001].Status)
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SysServices) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "NodeCommon":
+			bts, err = (*nodeCommon)(&z.NodeCommon).UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NodeCommon")
+				return
+			}
+		case "services":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Services")
+				return
+			}
+			if cap(z.Services) >= int(zb0002) {
+				z.Services = (z.Services)[:zb0002]
+			} else {
+				z.Services = make([]SysService, zb0002)
+			}
+			for za0001 := range z.Services {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Services", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Services", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "name":
+						z.Services[za0001].Name, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Services", za0001, "Name")
+							return
+						}
+					case "status":
+						z.Services[za0001].Status, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Services", za0001, "Status")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Services", za0001)
+							return
+						}
+					}
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Services = nil
+	}
+
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SysServices) Msgsize() (s int) {
+	s = 1 + 11 + (*nodeCommon)(&z.NodeCommon).Msgsize() + 9 + msgp.ArrayHeaderSize
+	for za0001 := range z.Services {
+		s += 1 + 5 + msgp.StringPrefixSize + len(z.Services[za0001].Name) + 7 + msgp.StringPrefixSize + len(z.Services[za0001].Status)
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *TLSCert) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "pub_key_algo":
+			z.PubKeyAlgo, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "PubKeyAlgo")
+				return
+			}
+		case "signature_algo":
+			z.SignatureAlgo, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "SignatureAlgo")
+				return
+			}
+		case "not_before":
+			z.NotBefore, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "NotBefore")
+				return
+			}
+		case "not_after":
+			z.NotAfter, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "NotAfter")
+				return
+			}
+		case "checksum":
+			z.Checksum, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Checksum")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *TLSCert) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "pub_key_algo"
+	err = en.Append(0x85, 0xac, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x6c, 0x67, 0x6f)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.PubKeyAlgo)
+	if err != nil {
+		err = msgp.WrapError(err, "PubKeyAlgo")
+		return
+	}
+	// write "signature_algo"
+	err = en.Append(0xae, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.SignatureAlgo)
+	if err != nil {
+		err = msgp.WrapError(err, "SignatureAlgo")
+		return
+	}
+	// write "not_before"
+	err = en.Append(0xaa, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.NotBefore)
+	if err != nil {
+		err = msgp.WrapError(err, "NotBefore")
+		return
+	}
+	// write "not_after"
+	err = en.Append(0xa9, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.NotAfter)
+	if err != nil {
+		err = msgp.WrapError(err, "NotAfter")
+		return
+	}
+	// write "checksum"
+	err = en.Append(0xa8, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Checksum)
+	if err != nil {
+		err = msgp.WrapError(err, "Checksum")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *TLSCert) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "pub_key_algo"
+	o = append(o, 0x85, 0xac, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x6c, 0x67, 0x6f)
+	o = msgp.AppendString(o, z.PubKeyAlgo)
+	// string "signature_algo"
+	o = append(o, 0xae, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f)
+	o = msgp.AppendString(o, z.SignatureAlgo)
+	// string "not_before"
+	o = append(o, 0xaa, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65)
+	o = msgp.AppendTime(o, z.NotBefore)
+	// string "not_after"
+	o = append(o, 0xa9, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72)
+	o = msgp.AppendTime(o, z.NotAfter)
+	// string "checksum"
+	o = append(o, 0xa8, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d)
+	o = msgp.AppendString(o, z.Checksum)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *TLSCert) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "pub_key_algo":
+			z.PubKeyAlgo, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PubKeyAlgo")
+				return
+			}
+		case "signature_algo":
+			z.SignatureAlgo, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SignatureAlgo")
+				return
+			}
+		case "not_before":
+			z.NotBefore, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NotBefore")
+				return
+			}
+		case "not_after":
+			z.NotAfter, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NotAfter")
+				return
+			}
+		case "checksum":
+			z.Checksum, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Checksum")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *TLSCert) Msgsize() (s int) {
+	s = 1 + 13 + msgp.StringPrefixSize + len(z.PubKeyAlgo) + 15 + msgp.StringPrefixSize + len(z.SignatureAlgo) + 11 + msgp.TimeSize + 10 + msgp.TimeSize + 9 + msgp.StringPrefixSize + len(z.Checksum)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *TLSInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "tls_enabled":
+			z.TLSEnabled, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "TLSEnabled")
+				return
+			}
+		case "certs":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Certs")
+				return
+			}
+			if cap(z.Certs) >= int(zb0002) {
+				z.Certs = (z.Certs)[:zb0002]
+			} else {
+				z.Certs = make([]TLSCert, zb0002)
+			}
+			for za0001 := range z.Certs {
+				err = z.Certs[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Certs", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Certs = nil
+	}
+
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *TLSInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Certs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "tls_enabled"
+		err = en.Append(0xab, 0x74, 0x6c, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64)
+		if err != nil {
+			return
+		}
+		err = en.WriteBool(z.TLSEnabled)
+		if err != nil {
+			err = msgp.WrapError(err, "TLSEnabled")
+			return
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "certs"
+			err = en.Append(0xa5, 0x63, 0x65, 0x72, 0x74, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Certs)))
+			if err != nil {
+				err = msgp.WrapError(err, "Certs")
+				return
+			}
+			for za0001 := range z.Certs {
+				err = z.Certs[za0001].EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "Certs", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *TLSInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Certs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "tls_enabled"
+		o = append(o, 0xab, 0x74, 0x6c, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64)
+		o = msgp.AppendBool(o, z.TLSEnabled)
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "certs"
+			o = append(o, 0xa5, 0x63, 0x65, 0x72, 0x74, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Certs)))
+			for za0001 := range z.Certs {
+				o, err = z.Certs[za0001].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Certs", za0001)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *TLSInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "tls_enabled":
+			z.TLSEnabled, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TLSEnabled")
+				return
+			}
+		case "certs":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Certs")
+				return
+			}
+			if cap(z.Certs) >= int(zb0002) {
+				z.Certs = (z.Certs)[:zb0002]
+			} else {
+				z.Certs = make([]TLSCert, zb0002)
+			}
+			for za0001 := range z.Certs {
+				bts, err = z.Certs[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Certs", za0001)
+					return
+				}
+			}
+			zb0001Mask |= 0x1
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.Certs = nil
+	}
+
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *TLSInfo) Msgsize() (s int) {
+	s = 1 + 12 + msgp.BoolSize + 6 + msgp.ArrayHeaderSize
+	for za0001 := range z.Certs {
+		s += z.Certs[za0001].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *TimeInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "current_time":
+			z.CurrentTime, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "CurrentTime")
+				return
+			}
+		case "roundtrip_duration":
+			z.RoundtripDuration, err = dc.ReadInt32()
+			if err != nil {
+				err = msgp.WrapError(err, "RoundtripDuration")
+				return
+			}
+		case "time_zone":
+			z.TimeZone, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "TimeZone")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z TimeInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "current_time"
+	err = en.Append(0x83, 0xac, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.CurrentTime)
+	if err != nil {
+		err = msgp.WrapError(err, "CurrentTime")
+		return
+	}
+	// write "roundtrip_duration"
+	err = en.Append(0xb2, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt32(z.RoundtripDuration)
+	if err != nil {
+		err = msgp.WrapError(err, "RoundtripDuration")
+		return
+	}
+	// write "time_zone"
+	err = en.Append(0xa9, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.TimeZone)
+	if err != nil {
+		err = msgp.WrapError(err, "TimeZone")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z TimeInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "current_time"
+	o = append(o, 0x83, 0xac, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65)
+	o = msgp.AppendTime(o, z.CurrentTime)
+	// string "roundtrip_duration"
+	o = append(o, 0xb2, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendInt32(o, z.RoundtripDuration)
+	// string "time_zone"
+	o = append(o, 0xa9, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65)
+	o = msgp.AppendString(o, z.TimeZone)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *TimeInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "current_time":
+			z.CurrentTime, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CurrentTime")
+				return
+			}
+		case "roundtrip_duration":
+			z.RoundtripDuration, bts, err = msgp.ReadInt32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RoundtripDuration")
+				return
+			}
+		case "time_zone":
+			z.TimeZone, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TimeZone")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z TimeInfo) Msgsize() (s int) {
+	s = 1 + 13 + msgp.TimeSize + 19 + msgp.Int32Size + 10 + msgp.StringPrefixSize + len(z.TimeZone)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *XFSErrorConfig) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "config_file":
+			z.ConfigFile, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "ConfigFile")
+				return
+			}
+		case "max_retries":
+			z.MaxRetries, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "MaxRetries")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z XFSErrorConfig) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "config_file"
+	err = en.Append(0x82, 0xab, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.ConfigFile)
+	if err != nil {
+		err = msgp.WrapError(err, "ConfigFile")
+		return
+	}
+	// write "max_retries"
+	err = en.Append(0xab, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.MaxRetries)
+	if err != nil {
+		err = msgp.WrapError(err, "MaxRetries")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z XFSErrorConfig) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "config_file"
+	o = append(o, 0x82, 0xab, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65)
+	o = msgp.AppendString(o, z.ConfigFile)
+	// string "max_retries"
+	o = append(o, 0xab, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73)
+	o = msgp.AppendInt(o, z.MaxRetries)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *XFSErrorConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "config_file":
+			z.ConfigFile, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ConfigFile")
+				return
+			}
+		case "max_retries":
+			z.MaxRetries, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxRetries")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z XFSErrorConfig) Msgsize() (s int) {
+	s = 1 + 12 + msgp.StringPrefixSize + len(z.ConfigFile) + 12 + msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *XFSErrorConfigs) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "configs":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Configs")
+				return
+			}
+			if cap(z.Configs) >= int(zb0002) {
+				z.Configs = (z.Configs)[:zb0002]
+			} else {
+				z.Configs = make([]XFSErrorConfig, zb0002)
+			}
+			for za0001 := range z.Configs {
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Configs", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "Configs", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "config_file":
+						z.Configs[za0001].ConfigFile, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Configs", za0001, "ConfigFile")
+							return
+						}
+					case "max_retries":
+						z.Configs[za0001].MaxRetries, err = dc.ReadInt()
+						if err != nil {
+							err = msgp.WrapError(err, "Configs", za0001, "MaxRetries")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Configs", za0001)
+							return
+						}
+					}
+				}
+			}
+			zb0001Mask |= 0x1
+		case "error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x2
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Configs = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Error = ""
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *XFSErrorConfigs) EncodeMsg(en *msgp.Writer) (err error) {
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Configs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
+	if err != nil {
+		return
+	}
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "configs"
+			err = en.Append(0xa7, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(len(z.Configs)))
+			if err != nil {
+				err = msgp.WrapError(err, "Configs")
+				return
+			}
+			for za0001 := range z.Configs {
+				// map header, size 2
+				// write "config_file"
+				err = en.Append(0x82, 0xab, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65)
+				if err != nil {
+					return
+				}
+				err = en.WriteString(z.Configs[za0001].ConfigFile)
+				if err != nil {
+					err = msgp.WrapError(err, "Configs", za0001, "ConfigFile")
+					return
+				}
+				// write "max_retries"
+				err = en.Append(0xab, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73)
+				if err != nil {
+					return
+				}
+				err = en.WriteInt(z.Configs[za0001].MaxRetries)
+				if err != nil {
+					err = msgp.WrapError(err, "Configs", za0001, "MaxRetries")
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// write "error"
+			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			if err != nil {
+				return
+			}
+			err = en.WriteString(z.Error)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *XFSErrorConfigs) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	if z.Configs == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	if z.Error == "" {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "configs"
+			o = append(o, 0xa7, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73)
+			o = msgp.AppendArrayHeader(o, uint32(len(z.Configs)))
+			for za0001 := range z.Configs {
+				// map header, size 2
+				// string "config_file"
+				o = append(o, 0x82, 0xab, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65)
+				o = msgp.AppendString(o, z.Configs[za0001].ConfigFile)
+				// string "max_retries"
+				o = append(o, 0xab, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73)
+				o = msgp.AppendInt(o, z.Configs[za0001].MaxRetries)
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
+			// string "error"
+			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
+			o = msgp.AppendString(o, z.Error)
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *XFSErrorConfigs) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 2 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "configs":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Configs")
+				return
+			}
+			if cap(z.Configs) >= int(zb0002) {
+				z.Configs = (z.Configs)[:zb0002]
+			} else {
+				z.Configs = make([]XFSErrorConfig, zb0002)
+			}
+			for za0001 := range z.Configs {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Configs", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Configs", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "config_file":
+						z.Configs[za0001].ConfigFile, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Configs", za0001, "ConfigFile")
+							return
+						}
+					case "max_retries":
+						z.Configs[za0001].MaxRetries, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Configs", za0001, "MaxRetries")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Configs", za0001)
+							return
+						}
+					}
+				}
+			}
+			zb0001Mask |= 0x1
+		case "error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+			zb0001Mask |= 0x2
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	// Clear omitted fields.
+	if zb0001Mask != 0x3 {
+		if (zb0001Mask & 0x1) == 0 {
+			z.Configs = nil
+		}
+		if (zb0001Mask & 0x2) == 0 {
+			z.Error = ""
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *XFSErrorConfigs) Msgsize() (s int) {
+	s = 1 + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Configs {
+		s += 1 + 12 + msgp.StringPrefixSize + len(z.Configs[za0001].ConfigFile) + 12 + msgp.IntSize
+	}
+	s += 6 + msgp.StringPrefixSize + len(z.Error)
+	return
+}
@@ -0,0 +1,191 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHealthInfoWithDeadline(t *testing.T) {
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"3"}` + "\n"))
+		w.Write([]byte(`{"version":"3","sys":{"cpus":[{"addr":"node1:9000"}]},"minio":{"info":{"mode":`))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+
+	types := []HealthDataType{HealthDataTypeSysCPU, HealthDataTypeSysMem, HealthDataTypeMinioInfo}
+	info, err := adm.HealthInfoWithDeadline(context.Background(), types, time.Second, "standard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Error != "timeout" {
+		t.Fatalf("expected timeout error, got %q", info.Error)
+	}
+	if len(info.Sys.CPUInfo) != 1 || info.Sys.CPUInfo[0].Addr != "node1:9000" {
+		t.Fatalf("expected cpu info to be received, got %v", info.Sys.CPUInfo)
+	}
+	if len(info.Sys.MemInfo) != 1 || info.Sys.MemInfo[0].Error != "timeout" {
+		t.Fatalf("expected mem info to time out, got %v", info.Sys.MemInfo)
+	}
+	if info.Minio.Error != "timeout" {
+		t.Fatalf("expected minio info to time out, got %q", info.Minio.Error)
+	}
+}
+
+func TestHealthInfoWithDeadlineServerDeadline(t *testing.T) {
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		deadline, err := time.ParseDuration(r.URL.Query().Get("deadline"))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		w.Write([]byte(`{"version":"3"}` + "\n"))
+		w.(http.Flusher).Flush()
+		// Answer with the complete results just before the server deadline.
+		time.Sleep(deadline - 50*time.Millisecond)
+		w.Write([]byte(`{"version":"3","sys":{"cpus":[{"addr":"node1:9000"}]}}`))
+	})
+
+	info, err := adm.HealthInfoWithDeadline(context.Background(), []HealthDataType{HealthDataTypeSysCPU}, 2*time.Second, "standard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Error != "" || len(info.Sys.CPUInfo) != 1 || info.Sys.CPUInfo[0].Error != "" {
+		t.Fatalf("expected the results sent before the server deadline, got %+v", info)
+	}
+}
+
+func TestHealthInfoVersion(t *testing.T) {
+	testCases := []struct {
+		version string
+		success bool
+	}{
+		{version: HealthInfoVersion0, success: true},
+		{version: HealthInfoVersion2, success: true},
+		{version: HealthInfoVersion3, success: true},
+		{version: HealthInfoVersion, success: true},
+		{version: HealthInfoVersion1},
+		{version: "4"},
+		{version: "v3"},
+	}
+
+	for i, tc := range testCases {
+		hv, err := ParseHealthInfoVersion(tc.version)
+		if tc.success != (err == nil) {
+			t.Fatalf("Test %d: unexpected error parsing %q: %v", i+1, tc.version, err)
+		}
+		if tc.success && string(hv) != tc.version {
+			t.Fatalf("Test %d: expected version %q, got %q", i+1, tc.version, hv)
+		}
+
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(HealthInfoVersionStruct{Version: tc.version})
+		})
+		resp, version, err := adm.ServerHealthInfo(context.Background(), nil, time.Second, "standard")
+		if tc.success != (err == nil) {
+			t.Fatalf("Test %d: ServerHealthInfo disagrees on version %q: %v", i+1, tc.version, err)
+		}
+		if err != nil {
+			if tc.version == HealthInfoVersion1 && strings.Contains(err.Error(), "upgrade") {
+				t.Fatalf("Test %d: expected legacy version not to suggest an upgrade, got %v", i+1, err)
+			}
+			continue
+		}
+		closeResponse(resp)
+		if version != tc.version {
+			t.Fatalf("Test %d: expected version %q, got %q", i+1, tc.version, version)
+		}
+	}
+}
+
+func TestDecodeHealthReport(t *testing.T) {
+	stream := `{"timestamp":"2024-01-02T03:04:05Z","error":"partial"}` + "\n" + `{"timestamp":"2024-01-02T03:04:06Z"}`
+	report, err := DecodeHealthReport(HealthInfoVersion0, strings.NewReader(stream))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v0, ok := report.(HealthInfoV0)
+	if !ok || v0.Error != "" || v0.TimeStamp.Second() != 6 {
+		t.Fatalf("expected the last version 0 report, got %#v", report)
+	}
+
+	stream = `{"version":"3","sys":{"cpus":[{"addr":"node1:9000"}]}}`
+	report, err = DecodeHealthReport(HealthInfoVersion3, strings.NewReader(stream))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v2, ok := report.(HealthInfoV2); !ok || len(v2.Sys.CPUInfo) != 1 {
+		t.Fatalf("expected a version 2 report, got %#v", report)
+	}
+
+	if _, err = DecodeHealthReport(HealthInfoVersion1, strings.NewReader(stream)); err == nil {
+		t.Fatal("expected version 1 to be rejected")
+	}
+}
+
+func TestHealthInfoWithDeadlinePerfCollectedAt(t *testing.T) {
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version":"3"}` + "\n"))
+		w.Write([]byte(`{"version":"3","perf":{"drives":[{"addr":"node1:9000"}]}}`))
+	})
+
+	before := time.Now()
+	info, err := adm.HealthInfoWithDeadline(context.Background(), nil, time.Second, "standard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if at := info.Perf.CollectedAt; at == nil || at.Before(before) || at.After(time.Now()) {
+		t.Fatalf("expected perf results to be stamped when received, got %v", at)
+	}
+
+	b, err := json.Marshal(PerfInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "collected_at") {
+		t.Fatalf("expected no collection time for unstamped results, got %s", b)
+	}
+}
+
+func TestGroupByNode(t *testing.T) {
+	perfs := []DrivePerfInfos{
+		{NodeCommon: NodeCommon{Addr: "node1:9000"}, SerialPerf: []DrivePerfInfo{{Path: "/mnt/disk1"}}},
+		{NodeCommon: NodeCommon{Addr: "node2:9000"}},
+		{NodeCommon: NodeCommon{Addr: "node1:9000"}, SerialPerf: []DrivePerfInfo{{Path: "/mnt/disk2"}}},
+		{NodeCommon: NodeCommon{Error: "unreachable"}},
+	}
+
+	groups := GroupByNode(perfs, func(d DrivePerfInfos) NodeCommon { return d.NodeCommon })
+	expected := map[string][]DrivePerfInfos{
+		"node1:9000":    {perfs[0], perfs[2]},
+		"node2:9000":    {perfs[1]},
+		UnknownNodeAddr: {perfs[3]},
+	}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Fatalf("expected %v, got %v", expected, groups)
+	}
+}
@@ -0,0 +1,271 @@
+//
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"bufio"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"time"
+)
+
+const (
+	zipLocalHeaderSignature   = 0x04034b50
+	zipCentralHeaderSignature = 0x02014b50
+	zipEndSignature           = 0x06054b50
+	zipDescriptorSignature    = 0x08074b50
+	zipLocalHeaderLen         = 30
+	zipFlagDescriptor         = 0x8
+	zipExtraZip64             = 0x0001
+)
+
+var errZipStreamFormat = errors.New("inspect: not a valid zip stream")
+
+// zipStreamReader reads the entries of a zip archive sequentially from
+// their local file headers, so the archive can be extracted while it is
+// received. The central directory is not read.
+type zipStreamReader struct {
+	r    *bufio.Reader
+	cur  *zipStreamEntry
+	done bool
+}
+
+func newZipStreamReader(r io.Reader) *zipStreamReader {
+	return &zipStreamReader{r: bufio.NewReader(r)}
+}
+
+// zipStreamHeader describes an entry read by zipStreamReader.
+type zipStreamHeader struct {
+	name     string
+	modified time.Time
+	// size is the uncompressed size, -1 if it is only known once the
+	// content has been read.
+	size int64
+}
+
+// Next advances to the next entry and returns its header and a reader for
+// its uncompressed content. The content is only valid until the next call
+// to Next. It returns io.EOF once the central directory is reached.
+func (z *zipStreamReader) Next() (h zipStreamHeader, r io.Reader, err error) {
+	if z.done {
+		return h, nil, io.EOF
+	}
+	if z.cur != nil {
+		// Skip what is left of the previous entry.
+		if _, err := io.Copy(io.Discard, z.cur); err != nil {
+			return h, nil, err
+		}
+		z.cur = nil
+	}
+
+	var hdr [zipLocalHeaderLen]byte
+	if _, err := io.ReadFull(z.r, hdr[:4]); err != nil {
+		return h, nil, noEOF(err)
+	}
+	switch binary.LittleEndian.Uint32(hdr[:4]) {
+	case zipLocalHeaderSignature:
+	case zipCentralHeaderSignature, zipEndSignature:
+		z.done = true
+		return h, nil, io.EOF
+	default:
+		return h, nil, errZipStreamFormat
+	}
+	if _, err := io.ReadFull(z.r, hdr[4:]); err != nil {
+		return h, nil, noEOF(err)
+	}
+	flags := binary.LittleEndian.Uint16(hdr[6:])
+	method := binary.LittleEndian.Uint16(hdr[8:])
+	e := &zipStreamEntry{
+		z:          z,
+		descriptor: flags&zipFlagDescriptor != 0,
+		crc:        crc32.NewIEEE(),
+		wantCRC:    binary.LittleEndian.Uint32(hdr[14:]),
+		csize:      uint64(binary.LittleEndian.Uint32(hdr[18:])),
+		usize:      uint64(binary.LittleEndian.Uint32(hdr[22:])),
+	}
+	nameLen := binary.LittleEndian.Uint16(hdr[26:])
+	extraLen := binary.LittleEndian.Uint16(hdr[28:])
+	buf := make([]byte, int(nameLen)+int(extraLen))
+	if _, err := io.ReadFull(z.r, buf); err != nil {
+		return h, nil, noEOF(err)
+	}
+	name := string(buf[:nameLen])
+	e.readZip64Extra(buf[nameLen:])
+
+	switch method {
+	case 0: // store
+		if e.descriptor {
+			return h, nil, fmt.Errorf("inspect: zip entry %q is stored with a data descriptor and cannot be streamed", name)
+		}
+		e.data = io.LimitReader(z.r, int64(e.csize))
+	case 8: // deflate
+		// flate only reads up to the end of the compressed data, since
+		// z.r implements io.ByteReader.
+		e.counter = &zipCountingReader{r: z.r}
+		e.data = flate.NewReader(e.counter)
+	default:
+		return h, nil, fmt.Errorf("inspect: zip entry %q uses unsupported compression method %d", name, method)
+	}
+	z.cur = e
+
+	h = zipStreamHeader{name: name, size: -1}
+	if !e.descriptor {
+		h.size = int64(e.usize)
+	}
+	if date := binary.LittleEndian.Uint16(hdr[12:]); date != 0 {
+		h.modified = msDosTime(date, binary.LittleEndian.Uint16(hdr[10:]))
+	}
+	return h, e, nil
+}
+
+// msDosTime converts an MS-DOS date and time to a time in UTC.
+func msDosTime(date, t uint16) time.Time {
+	return time.Date(
+		int(date>>9)+1980, time.Month(date>>5&0xf), int(date&0x1f),
+		int(t>>11), int(t>>5&0x3f), int(t&0x1f)*2, 0, time.UTC,
+	)
+}
+
+// zipStreamEntry reads the content of a single entry and verifies its
+// checksum and size once the end is reached.
+type zipStreamEntry struct {
+	z          *zipStreamReader
+	data       io.Reader
+	counter    *zipCountingReader
+	descriptor bool
+	crc        hash.Hash32
+	wantCRC    uint32
+	csize      uint64
+	usize      uint64
+	n          uint64
+	err        error
+}
+
+func (e *zipStreamEntry) Read(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	n, err := e.data.Read(p)
+	e.crc.Write(p[:n])
+	e.n += uint64(n)
+	if err == io.EOF {
+		err = e.finish()
+		if err == nil {
+			err = io.EOF
+		}
+	} else if err != nil {
+		err = noEOF(err)
+	}
+	e.err = err
+	return n, err
+}
+
+// finish reads the data descriptor, if any, and checks the content
+// against the checksum and size of the entry.
+func (e *zipStreamEntry) finish() error {
+	if e.descriptor {
+		if err := e.readDescriptor(); err != nil {
+			return err
+		}
+	}
+	if e.n != e.usize || e.crc.Sum32() != e.wantCRC {
+		return errors.New("inspect: zip entry checksum mismatch")
+	}
+	return nil
+}
+
+func (e *zipStreamEntry) readDescriptor() error {
+	var buf [24]byte
+	if _, err := io.ReadFull(e.z.r, buf[:4]); err != nil {
+		return noEOF(err)
+	}
+	// The descriptor signature is optional.
+	off := 0
+	if binary.LittleEndian.Uint32(buf[:4]) == zipDescriptorSignature {
+		off = 4
+	}
+	// Sizes are written as 64 bit values for zip64 entries only.
+	size := 12
+	if e.n >= 0xffffffff || (e.counter != nil && e.counter.n >= 0xffffffff) {
+		size = 20
+	}
+	if _, err := io.ReadFull(e.z.r, buf[4:size+off]); err != nil {
+		return noEOF(err)
+	}
+	d := buf[off:]
+	e.wantCRC = binary.LittleEndian.Uint32(d)
+	if size == 20 {
+		e.csize = binary.LittleEndian.Uint64(d[4:])
+		e.usize = binary.LittleEndian.Uint64(d[12:])
+	} else {
+		e.csize = uint64(binary.LittleEndian.Uint32(d[4:]))
+		e.usize = uint64(binary.LittleEndian.Uint32(d[8:]))
+	}
+	return nil
+}
+
+// readZip64Extra reads the 64 bit sizes from the zip64 extra field of the
+// local header, if the header sizes are saturated.
+func (e *zipStreamEntry) readZip64Extra(extra []byte) {
+	for len(extra) >= 4 {
+		tag := binary.LittleEndian.Uint16(extra)
+		size := int(binary.LittleEndian.Uint16(extra[2:]))
+		extra = extra[4:]
+		if size > len(extra) {
+			return
+		}
+		if tag == zipExtraZip64 {
+			f := extra[:size]
+			if e.usize == 0xffffffff && len(f) >= 8 {
+				e.usize, f = binary.LittleEndian.Uint64(f), f[8:]
+			}
+			if e.csize == 0xffffffff && len(f) >= 8 {
+				e.csize = binary.LittleEndian.Uint64(f)
+			}
+			return
+		}
+		extra = extra[size:]
+	}
+}
+
+// zipCountingReader counts the compressed bytes consumed by flate.
+type zipCountingReader struct {
+	r *bufio.Reader
+	n uint64
+}
+
+func (c *zipCountingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += uint64(n)
+	return n, err
+}
+
+func (c *zipCountingReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+	}
+	return b, err
+}
@@ -20,91 +20,1163 @@
 package madmin
 
 import (
+	"archive/tar"
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/secure-io/sio-go"
 )
 
+// ErrInspectDeadline is returned when InspectOptions.Deadline passes
+// before the inspect stream has been fully read.
+var ErrInspectDeadline = errors.New("inspect deadline exceeded")
+
+// ErrInspectChecksumMismatch is returned on Close when InspectOptions.Verify
+// is set and the received data doesn't match the checksum sent by the server.
+var ErrInspectChecksumMismatch = errors.New("inspect data checksum mismatch")
+
+// ErrInspectChecksumUnsupported is returned when InspectOptions.Verify is
+// set and the server doesn't send a checksum of the data.
+var ErrInspectChecksumUnsupported = errors.New("inspect data checksum not supported by server")
+
+// ErrInspectTooLarge is returned when the inspect stream exceeds
+// InspectOptions.MaxBytes.
+var ErrInspectTooLarge = errors.New("inspect data exceeds the size limit")
+
+// ErrInspectReadTimeout is returned when the server doesn't send data
+// for longer than InspectOptions.ReadTimeout.
+var ErrInspectReadTimeout = errors.New("inspect read timeout")
+
+// ErrUnknownInspectVersion is returned when the server sends inspect data
+// in a format not supported by this client. The returned error is an
+// *UnknownInspectVersionError matching it, the data can still be read
+// by using InspectOptions.RawStream.
+var ErrUnknownInspectVersion = errors.New("unknown data version")
+
+// UnknownInspectVersionError - the server sent inspect data in the
+// unsupported Format.
+type UnknownInspectVersionError struct {
+	Format byte
+}
+
+// Error returns the text of ErrUnknownInspectVersion.
+func (e *UnknownInspectVersionError) Error() string {
+	return ErrUnknownInspectVersion.Error()
+}
+
+// Is returns true if target is ErrUnknownInspectVersion.
+func (e *UnknownInspectVersionError) Is(target error) bool {
+	return target == ErrUnknownInspectVersion
+}
+
 // InspectOptions provides options to Inspect.
 type InspectOptions struct {
 	Volume, File string
-	PublicKey    []byte // PublicKey to use for inspected data.
+	PublicKey    []byte // PublicKey to use for inspected data, a PEM or DER encoded PKCS#1 RSA key.
+
+	// Deadline bounds the total runtime of the inspect, when set.
+	// Data read before the deadline remains valid, once it passes
+	// the remaining data is abandoned and ErrInspectDeadline is returned.
+	Deadline time.Time
+
+	// DriveIndices and SetIndex narrow the inspected files to the given
+	// drives of an erasure set. They are best-effort hints which are
+	// ignored by servers not supporting them. A nil SetIndex selects
+	// all sets.
+	DriveIndices []int
+	SetIndex     *int
+
+	// DriveUUID limits the inspect to the drive with the given MinIO
+	// drive UUID, when set. It requires MinIO RELEASE.2024-06-13T22-53-53Z
+	// or later, older servers ignore the "drive-uuid" parameter and
+	// inspect the selected files on all drives.
+	DriveUUID string
+
+	// MetaOnly requests only the xl.meta files of the selected objects,
+	// without their data parts. Servers not supporting it ignore it and
+	// return all selected files, so File should still select the files
+	// wanted when talking to older servers, e.g. "object/xl.meta".
+	MetaOnly bool
+
+	// Verify requests a SHA256 checksum of the data from the server which
+	// is verified when the returned reader is closed after reading all data.
+	// ErrInspectChecksumUnsupported is returned if the server doesn't send
+	// a checksum. Verify is not supported together with Offset. The data
+	// formats carrying a checksum include the key, so with PublicKey set
+	// no checksum is sent, and a checksum excludes the metadata trailer,
+	// so InspectResult.Metadata is empty when Verify is set.
+	Verify bool
+
+	// Offset resumes an interrupted download at the given byte offset of
+	// the raw inspect stream. When set, the returned reader starts at
+	// Offset without parsing the data format header, no key is returned
+	// and the key of the original download must be used. Offset is not
+	// supported together with PublicKey.
+	Offset int64
+
+	// MaxRetries is the number of times a failed request is retried, with
+	// exponential backoff, before giving up. Network errors and the
+	// transient 500, 502, 503 and 504 statuses are retried, other
+	// statuses never are. When 0, the request
+	// is attempted up to MaxRetry times like other requests of the
+	// client, a negative value disables retries.
+	MaxRetries int
+
+	// AcceptGzip asks the server to compress the inspect stream. The data
+	// returned is decompressed transparently and servers not supporting
+	// compression send it uncompressed. It is ignored when Offset is set.
+	AcceptGzip bool
+
+	// NewerThan limits the inspected files to those modified after the
+	// given time, when set. It is ignored by servers not supporting it.
+	NewerThan time.Time
+
+	// ContinuationToken continues an InspectListPage listing
+	// after the page which returned the token.
+	ContinuationToken string
+
+	// BufferSize is the read buffer size of the returned reader. Sizes
+	// outside of 4 KiB to 16 MiB are replaced by the default of 4 KiB.
+	BufferSize int
+
+	// MaxBytes limits the size of the inspect stream received, when set.
+	// If the server announces a larger size the inspect fails right away,
+	// otherwise ErrInspectTooLarge is returned once the limit is exceeded
+	// while reading. The limit applies to the decompressed stream.
+	MaxBytes int64
+
+	// EndpointOverride replaces the path of the inspect API relative to
+	// the admin API prefix, "/v4/inspect-data" by default, e.g. for
+	// proxies serving inspect elsewhere. It must start with "/". Listing
+	// uses the same path with a "-list" suffix.
+	EndpointOverride string
+
+	// RawStream returns the data as sent after the format byte and the
+	// key, if the format has one, without interpreting the framing of the
+	// format, e.g. to forward it to a separate decoder. The key is still
+	// returned for format 1, InspectResult.Format reports the format.
+	RawStream bool
+
+	// HashInto, when set, is fed all data read from the returned reader,
+	// e.g. to log a digest of exactly the data received. Headers parsed
+	// by Inspect, like the format byte and the key, are not included.
+	HashInto hash.Hash
+
+	// ExpectVersion fails the inspect if the data format sent by the
+	// server differs, when non-zero. It is ignored when Offset is set.
+	ExpectVersion byte
+
+	// ExtraHeaders are added to the inspect requests, e.g. request IDs
+	// required by proxies. All values of a header are sent. The
+	// Accept-Encoding, Content-Type and Range headers are managed by
+	// Inspect and are never taken from ExtraHeaders.
+	ExtraHeaders http.Header
+
+	// ReadTimeout fails the inspect with ErrInspectReadTimeout if waiting
+	// for the response or a single read of the data takes longer, when set.
+	// Unlike Deadline it bounds stalls, not the total runtime.
+	ReadTimeout time.Duration
 }
 
+// ParseInspectPath returns InspectOptions inspecting the path s, given as
+// "volume/file". The first path element is the volume and the remaining
+// elements are the file, which may contain wildcards.
+func ParseInspectPath(s string) (InspectOptions, error) {
+	volume, file, _ := strings.Cut(s, "/")
+	if volume == "" || file == "" {
+		return InspectOptions{}, ErrInvalidArgument(fmt.Sprintf("invalid inspect path %q, expected volume/file", s))
+	}
+	return InspectOptions{Volume: volume, File: file}, nil
+}
+
+// Validate returns an error if the options are not usable for an inspect,
+// e.g. because no volume is given.
+func (d InspectOptions) Validate() error {
+	if d.Volume == "" {
+		return ErrInvalidArgument("inspect volume must not be empty")
+	}
+	if d.Offset < 0 || (d.Offset > 0 && d.PublicKey != nil) {
+		return ErrInvalidArgument("inspect offset must be positive and cannot be used with a public key")
+	}
+	if d.Verify && d.Offset > 0 {
+		return ErrInvalidArgument("inspect verify cannot be used with an offset")
+	}
+	if d.EndpointOverride != "" && !strings.HasPrefix(d.EndpointOverride, "/") {
+		return ErrInvalidArgument("inspect endpoint override must start with /")
+	}
+	if d.PublicKey != nil {
+		return validateInspectPublicKey(d.PublicKey)
+	}
+	return nil
+}
+
+// extraHeaders returns a copy of ExtraHeaders without the headers
+// managed by Inspect.
+func (d InspectOptions) extraHeaders() http.Header {
+	h := d.ExtraHeaders.Clone()
+	if h == nil {
+		h = make(http.Header)
+	}
+	for k := range h {
+		switch http.CanonicalHeaderKey(k) {
+		case "Accept-Encoding", "Content-Type", "Range":
+			delete(h, k)
+		}
+	}
+	return h
+}
+
+// selectionValues returns the query values selecting the inspected files.
+// The values are percent-encoded when the request is sent, so volume and
+// file names may contain any character.
+func (d InspectOptions) selectionValues() url.Values {
+	values := make(url.Values)
+	values.Set("volume", d.Volume)
+	values.Set("file", d.File)
+	if len(d.DriveIndices) > 0 {
+		drives := make([]string, 0, len(d.DriveIndices))
+		for _, idx := range d.DriveIndices {
+			drives = append(drives, strconv.Itoa(idx))
+		}
+		values.Set("drives", strings.Join(drives, ","))
+	}
+	if d.SetIndex != nil {
+		values.Set("set", strconv.Itoa(*d.SetIndex))
+	}
+	if d.DriveUUID != "" {
+		values.Set("drive-uuid", d.DriveUUID)
+	}
+	if d.MetaOnly {
+		values.Set("meta-only", "true")
+	}
+	if !d.NewerThan.IsZero() {
+		values.Set("newer-than", d.NewerThan.UTC().Format(time.RFC3339Nano))
+	}
+	return values
+}
+
+// InspectResult is the result of an inspect call.
+type InspectResult struct {
+	// Key to decrypt the data with, empty if a public key was provided.
+	Key []byte
+	// Reader returns the inspected data.
+	Reader io.ReadCloser
+	// Metadata sent by the server after the data. It is only populated
+	// once Reader has returned io.EOF and is empty for data formats
+	// that don't carry metadata. The metadata is sent in a format of its
+	// own, which includes the key and no checksum, so it is never sent
+	// with InspectOptions.PublicKey or InspectOptions.Verify set.
+	Metadata map[string]string
+	// Format is the data format sent by the server, 0 when resuming
+	// at an offset.
+	Format byte
+}
+
+// inspectKeySize is the size of the key returned by Inspect.
+const inspectKeySize = 32
+
+// EncodeInspectKey returns the inspect key as a base64 string. It returns
+// an error if key does not have the size of the keys returned by Inspect.
+func EncodeInspectKey(key []byte) (string, error) {
+	if len(key) != inspectKeySize {
+		return "", fmt.Errorf("invalid inspect key: expected %d bytes, got %d", inspectKeySize, len(key))
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// DecodeInspectKey decodes an inspect key encoded by EncodeInspectKey.
+func DecodeInspectKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("invalid inspect key: %w", err)
+	}
+	if len(key) != inspectKeySize {
+		return nil, fmt.Errorf("invalid inspect key: expected %d bytes, got %d", inspectKeySize, len(key))
+	}
+	return key, nil
+}
+
+// Inspect read buffer sizes.
+const (
+	defaultInspectBufferSize = 4 << 10
+	minInspectBufferSize     = 4 << 10
+	maxInspectBufferSize     = 16 << 20
+)
+
+// bufferSize returns the read buffer size to use.
+func (d InspectOptions) bufferSize() int {
+	if d.BufferSize < minInspectBufferSize || d.BufferSize > maxInspectBufferSize {
+		return defaultInspectBufferSize
+	}
+	return d.BufferSize
+}
+
+// maxInspectMetadataSize is the largest metadata trailer accepted.
+const maxInspectMetadataSize = 1 << 20
+
+// inspectRetryUnit is the backoff unit between inspect retries.
+var inspectRetryUnit = DefaultRetryUnit
+
 // Inspect makes an admin call to download a raw files from disk.
 // If inspect is called with a public key no key will be returned
 // and the data is returned encrypted with the public key.
+// Canceling ctx or closing the returned reader aborts pending reads.
 func (adm *AdminClient) Inspect(ctx context.Context, d InspectOptions) (key []byte, c io.ReadCloser, err error) {
+	res, err := adm.InspectWithResult(ctx, d)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.Key, res.Reader, nil
+}
+
+// InspectWithResult is like Inspect, but returns an InspectResult
+// which also exposes the metadata sent by newer servers.
+func (adm *AdminClient) InspectWithResult(ctx context.Context, d InspectOptions) (res *InspectResult, err error) {
+	// The request is canceled when the returned reader is closed.
+	parentCtx := ctx
+	var stall *inspectStall
+	if d.ReadTimeout > 0 {
+		stall = newInspectStall(ctx, d.ReadTimeout)
+		ctx = stall.ctx
+	}
+	var cancel context.CancelFunc
+	if !d.Deadline.IsZero() {
+		ctx, cancel = context.WithDeadline(ctx, d.Deadline)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	if stall != nil {
+		reqCancel := cancel
+		cancel = func() {
+			reqCancel()
+			stall.stop()
+		}
+	}
+	defer func() {
+		if err != nil {
+			if stall.timedOut() {
+				err = ErrInspectReadTimeout
+			} else if ctx.Err() != nil && parentCtx.Err() == nil {
+				err = ErrInspectDeadline
+			}
+			cancel()
+		}
+	}()
+
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	relPath := adminAPIPrefixV4 + "/inspect-data"
+	if d.EndpointOverride != "" {
+		relPath = d.EndpointOverride
+	}
+
 	// Add form key/values in the body
-	form := make(url.Values)
-	form.Set("volume", d.Volume)
-	form.Set("file", d.File)
+	form := d.selectionValues()
 	if d.PublicKey != nil {
 		form.Set("public-key", base64.StdEncoding.EncodeToString(d.PublicKey))
 	}
+	if d.Verify {
+		form.Set("checksum", "true")
+	}
 
 	method := ""
 	reqData := requestData{
-		relPath: fmt.Sprintf(adminAPIPrefixV4 + "/inspect-data"),
+		relPath:       relPath,
+		customHeaders: d.extraHeaders(),
+	}
+	if d.AcceptGzip && d.Offset == 0 {
+		reqData.customHeaders.Set("Accept-Encoding", "gzip")
 	}
 
 	// If the public-key is specified, create a POST request and send
 	// parameters as multipart-form instead of query values
 	if d.PublicKey != nil {
 		method = http.MethodPost
-		reqData.customHeaders = make(http.Header)
 		reqData.customHeaders.Set("Content-Type", "application/x-www-form-urlencoded")
 		reqData.content = []byte(form.Encode())
 	} else {
 		method = http.MethodGet
 		reqData.queryValues = form
+		if d.Offset > 0 {
+			reqData.customHeaders.Set("Range", "bytes="+strconv.FormatInt(d.Offset, 10)+"-")
+		}
 	}
 
-	resp, err := adm.executeMethod(ctx, method, reqData)
+	resp, err := adm.executeInspect(ctx, method, reqData, d.MaxRetries)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	stall.pause()
+	respBody := stall.reader(resp.Body)
 
-	if resp.StatusCode != http.StatusOK {
+	switch {
+	case d.Offset > 0 && resp.StatusCode == http.StatusOK:
 		closeResponse(resp)
-		return nil, nil, httpRespToErrorResponse(resp)
+		return nil, errors.New("inspect resume not supported by server, received full content")
+	case resp.StatusCode != http.StatusOK && !(d.Offset > 0 && resp.StatusCode == http.StatusPartialContent):
+		err = httpRespToErrorResponse(resp)
+		closeResponse(resp)
+		return nil, err
 	}
 
-	bior := bufio.NewReaderSize(resp.Body, 4<<10)
+	// The size limit only applies to the data of successful responses.
+	gzipped := strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip")
+	if d.MaxBytes > 0 && !gzipped && resp.ContentLength > d.MaxBytes {
+		closeResponse(resp)
+		return nil, ErrInspectTooLarge
+	}
+
+	if d.Offset > 0 {
+		return &InspectResult{Reader: &closeWrapper{
+			Reader: &deadlineReader{ctx: ctx, parentCtx: parentCtx, r: d.hashReader(d.limitReader(respBody))},
+			Closer: resp.Body,
+			cancel: cancel,
+		}}, nil
+	}
+
+	body := respBody
+	if gzipped {
+		gz, err := gzip.NewReader(respBody)
+		if err != nil {
+			closeResponse(resp)
+			return nil, err
+		}
+		body = gz
+	}
+
+	bior := bufio.NewReaderSize(d.limitReader(body), d.bufferSize())
 	format, err := bior.ReadByte()
 	if err != nil {
 		closeResponse(resp)
-		return nil, nil, err
+		return nil, err
+	}
+
+	if d.ExpectVersion != 0 && format != d.ExpectVersion {
+		closeResponse(resp)
+		return nil, fmt.Errorf("unexpected data version %d, expected %d", format, d.ExpectVersion)
 	}
 
+	if d.Verify && !d.RawStream && format != 3 {
+		closeResponse(resp)
+		return nil, ErrInspectChecksumUnsupported
+	}
+
+	res = &InspectResult{Format: format}
+	var r io.Reader = bior
+	var verify func() error
+	// Formats 1, 3 and 4 carry the key, 3 adds a checksum and 4 a metadata
+	// trailer, they can't be combined. Format 2 is encrypted with the
+	// public key and carries neither.
 	switch format {
-	case 1:
-		key = make([]byte, 32)
+	case 1, 3, 4:
+		res.Key = make([]byte, inspectKeySize)
 		// Read key...
-		_, err = io.ReadFull(bior, key[:])
+		_, err = io.ReadFull(bior, res.Key[:])
 		if err != nil {
 			closeResponse(resp)
-			return nil, nil, err
+			return nil, err
+		}
+		if d.RawStream {
+			break
+		}
+		if format == 4 {
+			// Data is prefixed by its length and followed by a metadata trailer.
+			var size [8]byte
+			if _, err = io.ReadFull(bior, size[:]); err != nil {
+				closeResponse(resp)
+				return nil, err
+			}
+			r = &inspectTrailerReader{
+				data: io.LimitReader(bior, int64(binary.BigEndian.Uint64(size[:]))),
+				r:    bior,
+				res:  res,
+			}
+		}
+		if format == 3 {
+			// Data is followed by its SHA256 checksum.
+			cr := &inspectChecksumReader{r: bior, h: sha256.New()}
+			if d.Verify {
+				verify = cr.verify
+			}
+			r = cr
 		}
 	case 2:
+		if d.RawStream {
+			break
+		}
 		if err := bior.UnreadByte(); err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 	default:
+		if d.RawStream {
+			break
+		}
 		closeResponse(resp)
-		return nil, nil, errors.New("unknown data version")
+		return nil, &UnknownInspectVersionError{Format: format}
 	}
 
 	// Return body
-	return key, &closeWrapper{Reader: bior, Closer: resp.Body}, nil
+	res.Reader = &closeWrapper{
+		Reader: &deadlineReader{ctx: ctx, parentCtx: parentCtx, r: d.hashReader(r)},
+		Closer: resp.Body,
+		cancel: cancel,
+		verify: verify,
+	}
+	return res, nil
+}
+
+// InspectSize returns the size in bytes of the inspect stream the
+// selection of d would download, as announced by the server for a HEAD
+// request. It returns -1 if the server doesn't provide the size.
+func (adm *AdminClient) InspectSize(ctx context.Context, d InspectOptions) (int64, error) {
+	if err := d.Validate(); err != nil {
+		return 0, err
+	}
+	relPath := adminAPIPrefixV4 + "/inspect-data"
+	if d.EndpointOverride != "" {
+		relPath = d.EndpointOverride
+	}
+	resp, err := adm.executeMethod(ctx, http.MethodHead, requestData{
+		relPath:       relPath,
+		queryValues:   d.selectionValues(),
+		customHeaders: d.extraHeaders(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer closeResponse(resp)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if resp.ContentLength < 0 {
+			return -1, nil
+		}
+		return resp.ContentLength, nil
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return -1, nil
+	}
+	return 0, httpRespToErrorResponse(resp)
+}
+
+// InspectEntry is a file matched by an inspect.
+type InspectEntry struct {
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+}
+
+// InspectListResult is a page of files matched by an inspect.
+type InspectListResult struct {
+	Entries []InspectEntry `json:"entries"`
+	// NextContinuationToken is set if there are more entries,
+	// it is passed as InspectOptions.ContinuationToken to get them.
+	NextContinuationToken string `json:"nextContinuationToken,omitempty"`
+}
+
+// InspectList returns all files the selection of d would inspect,
+// without downloading their contents. Listing starts after
+// d.ContinuationToken, when set.
+func (adm *AdminClient) InspectList(ctx context.Context, d InspectOptions) ([]InspectEntry, error) {
+	var entries []InspectEntry
+	seen := map[string]bool{d.ContinuationToken: true}
+	for {
+		page, err := adm.InspectListPage(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, page.Entries...)
+		if page.NextContinuationToken == "" {
+			return entries, nil
+		}
+		if seen[page.NextContinuationToken] {
+			return nil, fmt.Errorf("inspect list: server repeated continuation token %q", page.NextContinuationToken)
+		}
+		seen[page.NextContinuationToken] = true
+		d.ContinuationToken = page.NextContinuationToken
+	}
+}
+
+// InspectListPage returns a page of the files the selection of d would
+// inspect, starting after d.ContinuationToken, when set. The listing is
+// served at the inspect API path with a "-list" suffix, including an
+// EndpointOverride.
+func (adm *AdminClient) InspectListPage(ctx context.Context, d InspectOptions) (InspectListResult, error) {
+	if err := d.Validate(); err != nil {
+		return InspectListResult{}, err
+	}
+	values := d.selectionValues()
+	if d.ContinuationToken != "" {
+		values.Set("continuation-token", d.ContinuationToken)
+	}
+	relPath := adminAPIPrefixV4 + "/inspect-data"
+	if d.EndpointOverride != "" {
+		relPath = d.EndpointOverride
+	}
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath:       relPath + "-list",
+		queryValues:   values,
+		customHeaders: d.extraHeaders(),
+	})
+	if err != nil {
+		return InspectListResult{}, err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return InspectListResult{}, httpRespToErrorResponse(resp)
+	}
+
+	var res InspectListResult
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return InspectListResult{}, err
+	}
+	return res, nil
+}
+
+// InspectToDir inspects the files selected by d and extracts them into dir,
+// preserving the paths of the inspected files. It returns the paths of the
+// written files. The files are extracted while the data is received, so on
+// failure the files written until then are returned along with the error.
+// Entries with paths outside dir are rejected. InspectToDir needs the
+// decryption key returned by the server and cannot be used with a public
+// key.
+func (adm *AdminClient) InspectToDir(ctx context.Context, d InspectOptions, dir string) ([]string, error) {
+	if d.PublicKey != nil {
+		return nil, ErrInvalidArgument("inspect to a directory cannot be used with a public key")
+	}
+	if d.Offset != 0 {
+		return nil, ErrInvalidArgument("inspect to a directory cannot be resumed at an offset")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	key, rc, err := adm.Inspect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	r, err := inspectDecryptReader(key, rc)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+
+	written, err := extractInspectStream(r, dir)
+	if cerr := rc.Close(); err == nil {
+		err = cerr
+	}
+	return written, err
+}
+
+// extractInspectStream extracts the zip archive read from r into dir and
+// returns the paths of the written files.
+func extractInspectStream(r io.Reader, dir string) ([]string, error) {
+	zr := newZipStreamReader(r)
+	var written []string
+	for {
+		h, fr, err := zr.Next()
+		if err == io.EOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+		fname := h.name
+		name := filepath.FromSlash(fname)
+		if !filepath.IsLocal(name) {
+			return written, fmt.Errorf("inspect file %q is outside of the target directory", fname)
+		}
+		if strings.HasSuffix(fname, "/") {
+			if err := os.MkdirAll(filepath.Join(dir, name), 0o755); err != nil {
+				return written, err
+			}
+			continue
+		}
+		dst := filepath.Join(dir, name)
+		if err := extractInspectFile(fr, dst); err != nil {
+			return written, err
+		}
+		written = append(written, dst)
+	}
+}
+
+// InspectTar inspects the files selected by d and writes them to w as a tar
+// archive, using the paths of the inspected files as entry names. The
+// archive is written while the data is received. Entries whose size is
+// only known once read are buffered one at a time, in memory up to 1 MiB
+// and in a temporary file beyond. Like InspectToDir it needs the
+// decryption key returned by the server and cannot be used with a public
+// key.
+func (adm *AdminClient) InspectTar(ctx context.Context, d InspectOptions, w io.Writer) error {
+	if d.PublicKey != nil {
+		return ErrInvalidArgument("inspect to a tar archive cannot be used with a public key")
+	}
+	if d.Offset != 0 {
+		return ErrInvalidArgument("inspect to a tar archive cannot be resumed at an offset")
+	}
+	key, rc, err := adm.Inspect(ctx, d)
+	if err != nil {
+		return err
+	}
+	r, err := inspectDecryptReader(key, rc)
+	if err != nil {
+		rc.Close()
+		return err
+	}
+
+	err = writeInspectTar(r, w)
+	if cerr := rc.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// writeInspectTar writes the entries of the zip archive read from r to w
+// as a tar archive.
+func writeInspectTar(r io.Reader, w io.Writer) error {
+	zr := newZipStreamReader(r)
+	tw := tar.NewWriter(w)
+	for {
+		h, fr, err := zr.Next()
+		if err == io.EOF {
+			return tw.Close()
+		}
+		if err != nil {
+			return err
+		}
+		if !filepath.IsLocal(filepath.FromSlash(h.name)) {
+			return fmt.Errorf("inspect file %q has an invalid path", h.name)
+		}
+		hdr := &tar.Header{
+			Name:    h.name,
+			Mode:    0o644,
+			Size:    h.size,
+			ModTime: h.modified,
+		}
+		if strings.HasSuffix(h.name, "/") {
+			hdr.Typeflag, hdr.Mode, hdr.Size = tar.TypeDir, 0o755, 0
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			continue
+		}
+		done := func() {}
+		if hdr.Size < 0 {
+			if fr, hdr.Size, done, err = bufferInspectEntry(fr); err != nil {
+				return err
+			}
+		}
+		err = tw.WriteHeader(hdr)
+		if err == nil {
+			_, err = io.Copy(tw, fr)
+		}
+		done()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// inspectEntryMemLimit is the size up to which bufferInspectEntry keeps
+// entries in memory.
+const inspectEntryMemLimit = 1 << 20
+
+// bufferInspectEntry reads r completely and returns a reader for its
+// content along with its size. Content larger than inspectEntryMemLimit
+// is written to a temporary file, which is removed by done.
+func bufferInspectEntry(r io.Reader) (buf io.Reader, size int64, done func(), err error) {
+	var mem bytes.Buffer
+	n, err := io.Copy(&mem, io.LimitReader(r, inspectEntryMemLimit+1))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if n <= inspectEntryMemLimit {
+		return &mem, n, func() {}, nil
+	}
+	tmp, err := os.CreateTemp("", ".inspect-*")
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	done = func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}
+	if size, err = io.Copy(tmp, io.MultiReader(&mem, r)); err == nil {
+		_, err = tmp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		done()
+		return nil, 0, nil, err
+	}
+	return tmp, size, done, nil
+}
+
+// inspectDecryptReader returns a reader decrypting the inspect data read
+// from r with key.
+func inspectDecryptReader(key []byte, r io.Reader) (io.Reader, error) {
+	stream, err := sio.AES_256_GCM.Stream(key)
+	if err != nil {
+		return nil, err
+	}
+	// The server uses a zero nonce, since each key is only used once.
+	nonce := make([]byte, stream.NonceSize())
+	return stream.DecryptReader(r, nonce, nil), nil
+}
+
+// extractInspectFile writes the content read from r to dst. The file is
+// removed again if r fails.
+func extractInspectFile(r io.Reader, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	w, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(dst)
+	}
+	return err
+}
+
+// validateInspectPublicKey returns an error if pk is not a PKCS#1 RSA
+// public key, the only encoding accepted by the server.
+func validateInspectPublicKey(pk []byte) error {
+	if block, _ := pem.Decode(pk); block != nil {
+		pk = block.Bytes
+	}
+	if _, err := x509.ParsePKCS1PublicKey(pk); err != nil {
+		return fmt.Errorf("invalid inspect public key, expected a PEM or DER encoded PKCS#1 RSA public key: %w", err)
+	}
+	return nil
+}
+
+// List of HTTP status codes for which inspect requests are retried.
+var retryableInspectStatusCodes = map[int]struct{}{
+	http.StatusInternalServerError: {},
+	http.StatusBadGateway:          {},
+	http.StatusServiceUnavailable:  {},
+	http.StatusGatewayTimeout:      {},
+}
+
+// executeInspect executes the inspect request, retrying on network errors
+// and transient 5xx statuses as selected by maxRetries, see
+// InspectOptions.MaxRetries. Responses with a 5xx status are returned as
+// errors.
+func (adm *AdminClient) executeInspect(ctx context.Context, method string, reqData requestData, maxRetries int) (*http.Response, error) {
+	attempts := MaxRetry
+	switch {
+	case maxRetries < 0:
+		attempts = 1
+	case maxRetries > 0:
+		attempts = maxRetries + 1
+	}
+	resp, err := adm.executeMethodRetry(ctx, method, reqData, attempts, inspectRetryUnit, func(status int, _ string) bool {
+		_, ok := retryableInspectStatusCodes[status]
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		err = httpRespToErrorResponse(resp)
+		closeResponse(resp)
+		return nil, err
+	}
+	return resp, nil
 }
 
 type closeWrapper struct {
 	io.Reader
 	io.Closer
+	cancel context.CancelFunc
+	verify func() error
+}
+
+// WriteTo writes the remaining data to w, using the fast path
+// of the underlying reader when available.
+func (c *closeWrapper) WriteTo(w io.Writer) (int64, error) {
+	if wt, ok := c.Reader.(io.WriterTo); ok {
+		return wt.WriteTo(w)
+	}
+	return io.Copy(w, struct{ io.Reader }{c.Reader})
+}
+
+func (c *closeWrapper) Close() error {
+	defer c.cancel()
+	err := c.Closer.Close()
+	if c.verify != nil {
+		if verr := c.verify(); verr != nil {
+			return verr
+		}
+	}
+	return err
+}
+
+// inspectChecksumReader returns the data of a format 3 stream, holding
+// back the trailing SHA256 checksum of the data.
+type inspectChecksumReader struct {
+	r   io.Reader
+	h   hash.Hash
+	buf []byte
+	err error
+	sum []byte // set once all data has been read
+}
+
+func (c *inspectChecksumReader) Read(p []byte) (int, error) {
+	for {
+		if len(c.buf) > sha256.Size {
+			n := copy(p, c.buf[:len(c.buf)-sha256.Size])
+			c.h.Write(p[:n])
+			c.buf = c.buf[n:]
+			return n, nil
+		}
+		if c.err == io.EOF {
+			if len(c.buf) < sha256.Size {
+				return 0, io.ErrUnexpectedEOF
+			}
+			c.sum = c.buf
+			return 0, io.EOF
+		}
+		if c.err != nil {
+			return 0, c.err
+		}
+		var tmp [32 << 10]byte
+		n, err := c.r.Read(tmp[:])
+		c.buf = append(c.buf, tmp[:n]...)
+		c.err = err
+	}
+}
+
+// verify returns ErrInspectChecksumMismatch if all data was read
+// and it doesn't match the checksum.
+func (c *inspectChecksumReader) verify() error {
+	if c.sum != nil && !bytes.Equal(c.sum, c.h.Sum(nil)) {
+		return ErrInspectChecksumMismatch
+	}
+	return nil
+}
+
+// limitReader returns r limited to d.MaxBytes, when set.
+func (d InspectOptions) limitReader(r io.Reader) io.Reader {
+	if d.MaxBytes <= 0 {
+		return r
+	}
+	return &maxBytesReader{r: r, n: d.MaxBytes}
+}
+
+// hashReader feeds the data read from r into d.HashInto, when set.
+func (d InspectOptions) hashReader(r io.Reader) io.Reader {
+	if d.HashInto == nil {
+		return r
+	}
+	return io.TeeReader(r, d.HashInto)
+}
+
+// maxBytesReader returns ErrInspectTooLarge once more than n bytes are read.
+type maxBytesReader struct {
+	r io.Reader
+	n int64 // remaining bytes
+}
+
+func (m *maxBytesReader) Read(p []byte) (int, error) {
+	// Read one byte more than allowed to detect exceeding the limit.
+	if int64(len(p)) > m.n+1 {
+		p = p[:m.n+1]
+	}
+	n, err := m.r.Read(p)
+	if int64(n) > m.n {
+		n = int(m.n)
+		m.n = 0
+		return n, ErrInspectTooLarge
+	}
+	m.n -= int64(n)
+	return n, err
+}
+
+// deadlineReader ties reads to the context of the inspect. Once the caller
+// canceled parentCtx reads fail with its error, read errors caused by an
+// expired InspectOptions.Deadline are translated into ErrInspectDeadline.
+type deadlineReader struct {
+	ctx, parentCtx context.Context
+	r              io.Reader
+}
+
+func (d *deadlineReader) Read(p []byte) (n int, err error) {
+	if err := d.parentCtx.Err(); err != nil {
+		return 0, err
+	}
+	n, err = d.r.Read(p)
+	if err != nil && err != io.EOF {
+		err = d.translate(err)
+	}
+	return n, err
+}
+
+func (d *deadlineReader) WriteTo(w io.Writer) (n int64, err error) {
+	if err := d.parentCtx.Err(); err != nil {
+		return 0, err
+	}
+	if wt, ok := d.r.(io.WriterTo); ok {
+		n, err = wt.WriteTo(w)
+	} else {
+		n, err = io.Copy(w, struct{ io.Reader }{d.r})
+	}
+	if err != nil {
+		err = d.translate(err)
+	}
+	return n, err
+}
+
+func (d *deadlineReader) translate(err error) error {
+	switch {
+	case err == ErrInspectReadTimeout:
+		return err
+	case d.parentCtx.Err() != nil:
+		return d.parentCtx.Err()
+	case d.ctx.Err() != nil:
+		return ErrInspectDeadline
+	}
+	return err
+}
+
+// inspectStall cancels its context when a timer armed before waiting for
+// the server isn't stopped within the timeout.
+type inspectStall struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	timeout time.Duration
+	timer   *time.Timer
+	fired   atomic.Bool
+}
+
+// newInspectStall returns an inspectStall with an armed timer.
+func newInspectStall(ctx context.Context, timeout time.Duration) *inspectStall {
+	s := &inspectStall{timeout: timeout}
+	s.ctx, s.cancel = context.WithCancel(ctx)
+	s.timer = time.AfterFunc(timeout, func() {
+		s.fired.Store(true)
+		s.cancel()
+	})
+	return s
+}
+
+// timedOut returns true if the timer fired, false for a nil s.
+func (s *inspectStall) timedOut() bool {
+	return s != nil && s.fired.Load()
+}
+
+// pause stops the timer until the next read, a nil s is ignored.
+func (s *inspectStall) pause() {
+	if s != nil {
+		s.timer.Stop()
+	}
+}
+
+// stop stops the timer and releases the context.
+func (s *inspectStall) stop() {
+	s.timer.Stop()
+	s.cancel()
+}
+
+// reader returns r with each read bounded by the timeout, or r for a nil s.
+func (s *inspectStall) reader(r io.Reader) io.Reader {
+	if s == nil {
+		return r
+	}
+	return &inspectStallReader{s: s, r: r}
+}
+
+type inspectStallReader struct {
+	s *inspectStall
+	r io.Reader
+}
+
+func (r *inspectStallReader) Read(p []byte) (n int, err error) {
+	if r.s.timedOut() {
+		return 0, ErrInspectReadTimeout
+	}
+	r.s.timer.Reset(r.s.timeout)
+	n, err = r.r.Read(p)
+	r.s.timer.Stop()
+	if err != nil && err != io.EOF && r.s.timedOut() {
+		err = ErrInspectReadTimeout
+	}
+	return n, err
+}
+
+// inspectTrailerReader returns the length prefixed data of a format 4
+// stream and decodes the metadata trailer into res once data is consumed.
+// The trailer is a 4 byte big endian length followed by a JSON object.
+type inspectTrailerReader struct {
+	data io.Reader
+	r    io.Reader
+	res  *InspectResult
+	err  error
+}
+
+func (t *inspectTrailerReader) Read(p []byte) (n int, err error) {
+	if t.err != nil {
+		return 0, t.err
+	}
+	n, err = t.data.Read(p)
+	if err == io.EOF {
+		err = t.readTrailer()
+		t.err = err
+	}
+	return n, err
+}
+
+func (t *inspectTrailerReader) readTrailer() error {
+	var size [4]byte
+	if _, err := io.ReadFull(t.r, size[:]); err != nil {
+		return fmt.Errorf("reading inspect metadata: %w", noEOF(err))
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if n > maxInspectMetadataSize {
+		return fmt.Errorf("inspect metadata too large: %d bytes", n)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(t.r, buf); err != nil {
+		return fmt.Errorf("reading inspect metadata: %w", noEOF(err))
+	}
+	var md map[string]string
+	if n > 0 {
+		if err := json.Unmarshal(buf, &md); err != nil {
+			return fmt.Errorf("decoding inspect metadata: %w", err)
+		}
+	}
+	t.res.Metadata = md
+	return io.EOF
+}
+
+// noEOF converts io.EOF into io.ErrUnexpectedEOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
 }
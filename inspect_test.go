@@ -0,0 +1,1588 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/secure-io/sio-go"
+)
+
+func newTestAdminClient(t testing.TB, handler http.HandlerFunc) *AdminClient {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	adm, err := New(strings.TrimPrefix(srv.URL, "http://"), "minioadmin", "minioadmin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return adm
+}
+
+func TestInspectDeadline(t *testing.T) {
+	payload := []byte("completed-part")
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte{1})
+		w.Write(bytes.Repeat([]byte{'k'}, 32))
+		w.Write(payload)
+		w.(http.Flusher).Flush()
+		// Stall the remaining files until the client gives up.
+		<-r.Context().Done()
+	})
+
+	_, rc, err := adm.Inspect(context.Background(), InspectOptions{
+		Volume:   "bucket",
+		File:     "object/xl.meta",
+		Deadline: time.Now().Add(200 * time.Millisecond),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if !errors.Is(err, ErrInspectDeadline) {
+		t.Fatalf("expected %v, got %v", ErrInspectDeadline, err)
+	}
+	if !bytes.Equal(data, payload) {
+		t.Fatalf("expected completed data %q, got %q", payload, data)
+	}
+}
+
+func TestInspectMetadataTrailer(t *testing.T) {
+	payload := []byte("inspect-payload")
+	metadata := []byte(`{"server":"node1","files":"2"}`)
+	key := bytes.Repeat([]byte{'k'}, 32)
+
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var buf bytes.Buffer
+		buf.WriteByte(4)
+		buf.Write(key)
+		binary.Write(&buf, binary.BigEndian, uint64(len(payload)))
+		buf.Write(payload)
+		binary.Write(&buf, binary.BigEndian, uint32(len(metadata)))
+		buf.Write(metadata)
+		w.Write(buf.Bytes())
+	})
+
+	res, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Reader.Close()
+
+	if !bytes.Equal(res.Key, key) {
+		t.Fatalf("unexpected key %q", res.Key)
+	}
+	data, err := io.ReadAll(res.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, payload) {
+		t.Fatalf("expected payload %q, got %q", payload, data)
+	}
+	if res.Metadata["server"] != "node1" || res.Metadata["files"] != "2" {
+		t.Fatalf("unexpected metadata %v", res.Metadata)
+	}
+}
+
+func TestInspectMetadataTrailerTruncated(t *testing.T) {
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var buf bytes.Buffer
+		buf.WriteByte(4)
+		buf.Write(bytes.Repeat([]byte{'k'}, 32))
+		binary.Write(&buf, binary.BigEndian, uint64(4))
+		buf.WriteString("data")
+		binary.Write(&buf, binary.BigEndian, uint32(100))
+		buf.WriteString(`{"server":`)
+		w.Write(buf.Bytes())
+	})
+
+	res, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Reader.Close()
+
+	if _, err = io.ReadAll(res.Reader); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected %v, got %v", io.ErrUnexpectedEOF, err)
+	}
+}
+
+func TestInspectDriveFilter(t *testing.T) {
+	var query url.Values
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte{1})
+		w.Write(bytes.Repeat([]byte{'k'}, 32))
+	})
+
+	setIndex := 0
+	_, rc, err := adm.Inspect(context.Background(), InspectOptions{
+		Volume:       "bucket",
+		File:         "object/xl.meta",
+		DriveIndices: []int{1, 3},
+		SetIndex:     &setIndex,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc.Close()
+
+	if query.Get("drives") != "1,3" || query.Get("set") != "0" {
+		t.Fatalf("unexpected drive filter query %v", query)
+	}
+}
+
+func TestInspectVerify(t *testing.T) {
+	payload := bytes.Repeat([]byte("inspect-data"), 10<<10)
+	sum := sha256.Sum256(payload)
+
+	testCases := []struct {
+		sum         []byte
+		expectedErr error
+	}{
+		{sum: sum[:]},
+		{sum: make([]byte, sha256.Size), expectedErr: ErrInspectChecksumMismatch},
+	}
+
+	for i, testCase := range testCases {
+		var query url.Values
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			w.Write([]byte{3})
+			w.Write(bytes.Repeat([]byte{'k'}, 32))
+			w.Write(payload)
+			w.Write(testCase.sum)
+		})
+
+		_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", Verify: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if query.Get("checksum") != "true" {
+			t.Fatalf("Test %d: expected checksum to be requested", i+1)
+		}
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, payload) {
+			t.Fatalf("Test %d: data mismatch, got %d bytes", i+1, len(data))
+		}
+		if err = rc.Close(); err != testCase.expectedErr {
+			t.Fatalf("Test %d: expected %v, got %v", i+1, testCase.expectedErr, err)
+		}
+	}
+}
+
+func TestInspectVerifyUnsupported(t *testing.T) {
+	// The server ignores checksum=true and sends the data without checksum.
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte{1})
+		w.Write(bytes.Repeat([]byte{'k'}, 32))
+		w.Write([]byte("inspect-data"))
+	})
+
+	_, _, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", Verify: true})
+	if err != ErrInspectChecksumUnsupported {
+		t.Fatalf("expected %v, got %v", ErrInspectChecksumUnsupported, err)
+	}
+
+	res, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", Verify: true, RawStream: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Reader.Close()
+
+	_, _, err = adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", Verify: true, Offset: 10})
+	if err == nil {
+		t.Fatal("expected verify with an offset to be rejected")
+	}
+}
+
+func TestInspectOffset(t *testing.T) {
+	stream := append([]byte{1}, bytes.Repeat([]byte{'k'}, 32)...)
+	stream = append(stream, []byte("0123456789")...)
+
+	for _, supportsRange := range []bool{true, false} {
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if !supportsRange {
+				w.Write(stream)
+				return
+			}
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(stream))
+		})
+
+		_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", Offset: 38})
+		if !supportsRange {
+			if err == nil {
+				rc.Close()
+				t.Fatal("expected an error when the server ignores the range")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "56789" {
+			t.Fatalf("expected data from offset, got %q", data)
+		}
+	}
+}
+
+func TestInspectRetry(t *testing.T) {
+	defer func(unit time.Duration) { inspectRetryUnit = unit }(inspectRetryUnit)
+	inspectRetryUnit = time.Millisecond
+
+	testCases := []struct {
+		failures   int
+		status     int
+		code       string
+		maxRetries int
+		calls      int
+		success    bool
+	}{
+		{failures: 2, status: http.StatusInternalServerError, maxRetries: 3, calls: 3, success: true},
+		{failures: 5, status: http.StatusInternalServerError, maxRetries: 2, calls: 3},
+		{failures: 1, status: http.StatusInternalServerError, maxRetries: -1, calls: 1},
+		{failures: 1, status: http.StatusForbidden, maxRetries: 3, calls: 1},
+		// The client retries apply when MaxRetries is 0.
+		{failures: 2, status: http.StatusServiceUnavailable, maxRetries: 0, calls: 3, success: true},
+		{failures: 20, status: http.StatusInternalServerError, maxRetries: 0, calls: MaxRetry},
+		{failures: 2, status: http.StatusServiceUnavailable, maxRetries: 2, calls: 3, success: true},
+		{failures: 5, status: http.StatusServiceUnavailable, maxRetries: 1, calls: 2},
+		// Non-transient 5xx statuses are not retried.
+		{failures: 5, status: http.StatusNotImplemented, maxRetries: 0, calls: 1},
+		{failures: 5, status: http.StatusHTTPVersionNotSupported, maxRetries: 3, calls: 1},
+		{failures: 2, status: http.StatusGatewayTimeout, maxRetries: 2, calls: 3, success: true},
+		// 4xx statuses are never retried.
+		{failures: 5, status: http.StatusTooManyRequests, maxRetries: 1, calls: 1},
+		{failures: 5, status: http.StatusRequestTimeout, maxRetries: 0, calls: 1},
+		{failures: 5, status: http.StatusBadRequest, code: "SlowDown", maxRetries: 0, calls: 1},
+	}
+
+	for i, tc := range testCases {
+		calls := 0
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls <= tc.failures {
+				w.WriteHeader(tc.status)
+				if tc.code != "" {
+					w.Write([]byte(`{"Code":"` + tc.code + `"}`))
+				}
+				return
+			}
+			w.Write([]byte{1})
+			w.Write(bytes.Repeat([]byte{'k'}, 32))
+		})
+
+		_, rc, err := adm.Inspect(context.Background(), InspectOptions{
+			Volume:     "bucket",
+			File:       "object/xl.meta",
+			MaxRetries: tc.maxRetries,
+		})
+		if tc.success != (err == nil) {
+			t.Fatalf("case %d: unexpected error %v", i+1, err)
+		}
+		if err == nil {
+			rc.Close()
+		}
+		if calls != tc.calls {
+			t.Fatalf("case %d: expected %d calls, got %d", i+1, tc.calls, calls)
+		}
+	}
+}
+
+func TestInspectRetryCanceled(t *testing.T) {
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, _, err := adm.Inspect(ctx, InspectOptions{
+		Volume:     "bucket",
+		File:       "object/xl.meta",
+		MaxRetries: 100,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestInspectPublicKeyValidation(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs1 := x509.MarshalPKCS1PublicKey(&key.PublicKey)
+	pkix, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPkix, err := x509.MarshalPKIXPublicKey(ed.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		key     []byte
+		success bool
+	}{
+		{key: pkcs1, success: true},
+		{key: pkix},
+		{key: pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: pkcs1}), success: true},
+		{key: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix})},
+		{key: []byte("not-a-key")},
+		{key: edPkix},
+	}
+
+	for i, tc := range testCases {
+		calls := 0
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Write([]byte{2})
+		})
+
+		_, rc, err := adm.Inspect(context.Background(), InspectOptions{
+			Volume:    "bucket",
+			File:      "object/xl.meta",
+			PublicKey: tc.key,
+		})
+		if tc.success != (err == nil) {
+			t.Fatalf("case %d: unexpected error %v", i+1, err)
+		}
+		if err != nil {
+			if calls != 0 {
+				t.Fatalf("case %d: expected no request to be sent", i+1)
+			}
+			continue
+		}
+		rc.Close()
+	}
+}
+
+func TestInspectAcceptGzip(t *testing.T) {
+	payload := bytes.Repeat([]byte("inspect-data"), 100)
+	for _, compress := range []bool{true, false} {
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Accept-Encoding") != "gzip" {
+				t.Errorf("expected gzip to be accepted, got %q", r.Header.Get("Accept-Encoding"))
+			}
+			var out io.Writer = w
+			if compress {
+				w.Header().Set("Content-Encoding", "gzip")
+				gz := gzip.NewWriter(w)
+				defer gz.Close()
+				out = gz
+			}
+			out.Write([]byte{1})
+			out.Write(bytes.Repeat([]byte{'k'}, 32))
+			out.Write(payload)
+		})
+
+		key, rc, err := adm.Inspect(context.Background(), InspectOptions{
+			Volume:     "bucket",
+			File:       "object/xl.meta",
+			AcceptGzip: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(key, bytes.Repeat([]byte{'k'}, 32)) {
+			t.Fatalf("compress=%v: unexpected key %q", compress, key)
+		}
+		if !bytes.Equal(data, payload) {
+			t.Fatalf("compress=%v: unexpected data %q", compress, data)
+		}
+	}
+}
+
+// inspectZipHandler serves files as a format 1 encrypted inspect zip.
+func inspectZipHandler(t *testing.T, files ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := bytes.Repeat([]byte{'k'}, 32)
+		w.Write([]byte{1})
+		w.Write(key)
+		stream, err := sio.AES_256_GCM.Stream(key)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		encw := stream.EncryptWriter(w, make([]byte, stream.NonceSize()), nil)
+		zw := zip.NewWriter(encw)
+		for _, name := range files {
+			fw, err := zw.Create(name)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			fw.Write([]byte("content of " + name))
+		}
+		zw.Close()
+		encw.Close()
+	}
+}
+
+func TestInspectToDir(t *testing.T) {
+	dir := t.TempDir()
+	adm := newTestAdminClient(t, inspectZipHandler(t, "node1/drive1/bucket/object/xl.meta", "node2/drive1/bucket/object/xl.meta"))
+
+	written, err := adm.InspectToDir(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"}, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		filepath.Join(dir, "node1", "drive1", "bucket", "object", "xl.meta"),
+		filepath.Join(dir, "node2", "drive1", "bucket", "object", "xl.meta"),
+	}
+	if !reflect.DeepEqual(written, want) {
+		t.Fatalf("expected %v, got %v", want, written)
+	}
+	data, err := os.ReadFile(want[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "content of node2/drive1/bucket/object/xl.meta" {
+		t.Fatalf("unexpected content %q", data)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected temporary files to be removed, got %d entries", len(entries))
+	}
+}
+
+func TestInspectToDirTraversal(t *testing.T) {
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "out")
+	adm := newTestAdminClient(t, inspectZipHandler(t, "node1/xl.meta", "../escaped", "node2/xl.meta"))
+
+	written, err := adm.InspectToDir(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"}, dir)
+	if err == nil {
+		t.Fatal("expected traversal to be rejected")
+	}
+	if want := []string{filepath.Join(dir, "node1", "xl.meta")}; !reflect.DeepEqual(written, want) {
+		t.Fatalf("expected partial result %v, got %v", want, written)
+	}
+	if _, err := os.Stat(filepath.Join(parent, "escaped")); !os.IsNotExist(err) {
+		t.Fatalf("file was written outside the target directory: %v", err)
+	}
+}
+
+func TestInspectToDirTruncated(t *testing.T) {
+	dir := t.TempDir()
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key := bytes.Repeat([]byte{'k'}, 32)
+		w.Write([]byte{1})
+		w.Write(key)
+		stream, err := sio.AES_256_GCM.Stream(key)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		encw := stream.EncryptWriter(w, make([]byte, stream.NonceSize()), nil)
+		zw := zip.NewWriter(encw)
+		for _, name := range []string{"node1/xl.meta", "node2/xl.meta"} {
+			fw, err := zw.Create(name)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			fw.Write(bytes.Repeat([]byte("content of "+name), 1000))
+		}
+		// End the stream in the middle of the second file.
+		zw.Flush()
+		encw.Close()
+	})
+
+	written, err := adm.InspectToDir(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"}, dir)
+	if err == nil {
+		t.Fatal("expected truncated stream to fail")
+	}
+	if want := []string{filepath.Join(dir, "node1", "xl.meta")}; !reflect.DeepEqual(written, want) {
+		t.Fatalf("expected partial result %v, got %v", want, written)
+	}
+	data, err := os.ReadFile(written[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := bytes.Repeat([]byte("content of node1/xl.meta"), 1000); !bytes.Equal(data, want) {
+		t.Fatalf("unexpected content of %s", written[0])
+	}
+	if _, err := os.Stat(filepath.Join(dir, "node2", "xl.meta")); !os.IsNotExist(err) {
+		t.Fatalf("expected incomplete file to be removed: %v", err)
+	}
+}
+
+func TestZipStreamReader(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := []struct {
+		name   string
+		method uint16
+		data   []byte
+	}{
+		{"deflated", zip.Deflate, bytes.Repeat([]byte("deflated content"), 5000)},
+		{"stored", zip.Store, []byte("stored content")},
+		{"dir/", zip.Store, nil},
+		{"empty", zip.Deflate, nil},
+	}
+	for _, f := range files {
+		var fw io.Writer
+		var err error
+		if f.method == zip.Deflate {
+			// Compressed entries are written with a data descriptor.
+			fw, err = zw.Create(f.name)
+		} else {
+			fw, err = zw.CreateRaw(&zip.FileHeader{
+				Name:               f.name,
+				Method:             zip.Store,
+				CRC32:              crc32.ChecksumIEEE(f.data),
+				UncompressedSize64: uint64(len(f.data)),
+				CompressedSize64:   uint64(len(f.data)),
+			})
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write(f.data)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr := newZipStreamReader(&buf)
+	for _, f := range files {
+		h, r, err := zr.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.name != f.name || !bytes.Equal(data, f.data) {
+			t.Fatalf("expected %s with %d bytes, got %s with %d bytes", f.name, len(f.data), h.name, len(data))
+		}
+		wantSize := int64(len(f.data))
+		if f.method == zip.Deflate {
+			wantSize = -1
+		}
+		if h.size != wantSize {
+			t.Fatalf("expected size %d of %s, got %d", wantSize, f.name, h.size)
+		}
+	}
+	if _, _, err := zr.Next(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestInspectErrorStatusCode(t *testing.T) {
+	for _, status := range []int{http.StatusForbidden, http.StatusNotFound} {
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"Code":"XMinioAdminError","Message":"inspect failed"}`))
+		})
+
+		_, _, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+		var sc interface{ StatusCode() int }
+		if !errors.As(err, &sc) || sc.StatusCode() != status {
+			t.Fatalf("expected error with status %d, got %v", status, err)
+		}
+		if ToErrorResponse(err).StatusCode() != status {
+			t.Fatalf("expected ErrorResponse with status %d, got %v", status, err)
+		}
+	}
+}
+
+func TestInspectNewerThan(t *testing.T) {
+	since := time.Date(2024, 5, 1, 12, 30, 0, 500, time.FixedZone("CEST", 2*60*60))
+	var got url.Values
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		w.Write([]byte{2})
+	})
+
+	for _, newerThan := range []time.Time{since, {}} {
+		_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", NewerThan: newerThan})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rc.Close()
+		want := ""
+		if !newerThan.IsZero() {
+			want = "2024-05-01T10:30:00.0000005Z"
+		}
+		if got.Get("newer-than") != want {
+			t.Fatalf("expected newer-than %q, got %q", want, got.Get("newer-than"))
+		}
+	}
+}
+
+func TestInspectWriteTo(t *testing.T) {
+	payload := bytes.Repeat([]byte("inspect-payload"), 10000)
+	for _, format := range []byte{1, 4} {
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte{format})
+			w.Write(bytes.Repeat([]byte{'k'}, 32))
+			if format == 4 {
+				binary.Write(w, binary.BigEndian, uint64(len(payload)))
+			}
+			w.Write(payload)
+			if format == 4 {
+				md := []byte(`{"files":"1"}`)
+				binary.Write(w, binary.BigEndian, uint32(len(md)))
+				w.Write(md)
+			}
+		})
+
+		res, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		wt, ok := res.Reader.(io.WriterTo)
+		if !ok {
+			t.Fatal("inspect reader doesn't implement io.WriterTo")
+		}
+		var buf bytes.Buffer
+		n, err := wt.WriteTo(&buf)
+		res.Reader.Close()
+		if err != nil {
+			t.Fatalf("format %d: %v", format, err)
+		}
+		if n != int64(len(payload)) || !bytes.Equal(buf.Bytes(), payload) {
+			t.Fatalf("format %d: expected %d bytes of payload, got %d", format, len(payload), n)
+		}
+		if format == 4 && res.Metadata["files"] != "1" {
+			t.Fatalf("expected metadata to be read, got %v", res.Metadata)
+		}
+	}
+}
+
+func BenchmarkInspectCopy(b *testing.B) {
+	payload := bytes.Repeat([]byte{'x'}, 8<<20)
+	adm := newTestAdminClient(b, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte{1})
+		w.Write(bytes.Repeat([]byte{'k'}, 32))
+		w.Write(payload)
+	})
+
+	bench := func(b *testing.B, wrap func(io.Reader) io.Reader) {
+		b.SetBytes(int64(len(payload)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := io.Copy(io.Discard, wrap(rc)); err != nil {
+				b.Fatal(err)
+			}
+			rc.Close()
+		}
+	}
+	b.Run("WriteTo", func(b *testing.B) {
+		bench(b, func(r io.Reader) io.Reader { return r })
+	})
+	b.Run("Read", func(b *testing.B) {
+		bench(b, func(r io.Reader) io.Reader { return struct{ io.Reader }{r} })
+	})
+}
+
+func TestInspectList(t *testing.T) {
+	mtime := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	pages := map[string]InspectListResult{
+		"": {
+			Entries:               []InspectEntry{{Path: "node1/drive1/bucket/object/xl.meta", Size: 100, ModTime: mtime}},
+			NextContinuationToken: "page2",
+		},
+		"page2": {
+			Entries: []InspectEntry{{Path: "node2/drive1/bucket/object/xl.meta", Size: 200, ModTime: mtime}},
+		},
+	}
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/inspect-data-list") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("file") != "object/xl.meta" {
+			t.Errorf("unexpected file %q", r.URL.Query().Get("file"))
+		}
+		page, ok := pages[r.URL.Query().Get("continuation-token")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(page)
+	})
+
+	d := InspectOptions{Volume: "bucket", File: "object/xl.meta"}
+	page, err := adm.InspectListPage(context.Background(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(page, pages[""]) {
+		t.Fatalf("expected first page %v, got %v", pages[""], page)
+	}
+
+	entries, err := adm.InspectList(context.Background(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append(append([]InspectEntry{}, pages[""].Entries...), pages["page2"].Entries...)
+	if !reflect.DeepEqual(entries, want) {
+		t.Fatalf("expected %v, got %v", want, entries)
+	}
+
+	d.ContinuationToken = "unknown"
+	if _, err = adm.InspectList(context.Background(), d); err == nil {
+		t.Fatal("expected invalid continuation token to fail")
+	}
+}
+
+func TestInspectListRepeatedToken(t *testing.T) {
+	testCases := []map[string]string{
+		{"": "page2", "page2": "page2"},
+		{"": "page2", "page2": "page3", "page3": "page2"},
+		{"": "page2", "page2": ""},
+	}
+	for i, tokens := range testCases {
+		calls := 0
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls > 10 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			next := tokens[r.URL.Query().Get("continuation-token")]
+			json.NewEncoder(w).Encode(InspectListResult{NextContinuationToken: next})
+		})
+
+		_, err := adm.InspectList(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+		if last := i == len(testCases)-1; last != (err == nil) {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if calls != len(tokens) {
+			t.Fatalf("Test %d: expected %d requests, got %d", i+1, len(tokens), calls)
+		}
+	}
+}
+
+func TestInspectListPageOptions(t *testing.T) {
+	var path, requestID string
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path, requestID = r.URL.Path, r.Header.Get("X-Request-Id")
+		json.NewEncoder(w).Encode(InspectListResult{})
+	})
+
+	for _, tc := range []struct {
+		override, path string
+	}{
+		{override: "", path: "/minio/admin/v4/inspect-data-list"},
+		{override: "/custom/inspect", path: "/minio/admin/custom/inspect-list"},
+	} {
+		_, err := adm.InspectListPage(context.Background(), InspectOptions{
+			Volume:           "bucket",
+			File:             "object/xl.meta",
+			EndpointOverride: tc.override,
+			ExtraHeaders:     http.Header{"X-Request-Id": {"req-1"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != tc.path {
+			t.Fatalf("expected request to %s, got %s", tc.path, path)
+		}
+		if requestID != "req-1" {
+			t.Fatalf("expected extra header to be sent, got %q", requestID)
+		}
+	}
+
+	if _, err := adm.InspectListPage(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", EndpointOverride: "custom"}); err == nil {
+		t.Fatal("expected relative override to be rejected")
+	}
+}
+
+func TestInspectBufferSize(t *testing.T) {
+	testCases := []struct {
+		size, want int
+	}{
+		{size: 0, want: 4 << 10},
+		{size: 1 << 10, want: 4 << 10},
+		{size: 1 << 20, want: 1 << 20},
+		{size: 16 << 20, want: 16 << 20},
+		{size: 32 << 20, want: 4 << 10},
+	}
+	for i, tc := range testCases {
+		if got := (InspectOptions{BufferSize: tc.size}).bufferSize(); got != tc.want {
+			t.Fatalf("case %d: expected %d, got %d", i+1, tc.want, got)
+		}
+	}
+}
+
+func BenchmarkInspectBufferSize(b *testing.B) {
+	payload := bytes.Repeat([]byte{'x'}, 8<<20)
+	adm := newTestAdminClient(b, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte{1})
+		w.Write(bytes.Repeat([]byte{'k'}, 32))
+		// Write in small chunks, like a slow link would deliver them.
+		for chunk := payload; len(chunk) > 0; chunk = chunk[1<<10:] {
+			w.Write(chunk[:1<<10])
+		}
+	})
+
+	for _, size := range []int{4 << 10, 64 << 10, 1 << 20} {
+		b.Run(strconv.Itoa(size>>10)+"KiB", func(b *testing.B) {
+			b.SetBytes(int64(len(payload)))
+			buf := make([]byte, 32<<10)
+			for i := 0; i < b.N; i++ {
+				_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", BufferSize: size})
+				if err != nil {
+					b.Fatal(err)
+				}
+				if _, err := io.CopyBuffer(io.Discard, struct{ io.Reader }{rc}, buf); err != nil {
+					b.Fatal(err)
+				}
+				rc.Close()
+			}
+		})
+	}
+}
+
+func TestParseInspectPath(t *testing.T) {
+	testCases := []struct {
+		path    string
+		volume  string
+		file    string
+		success bool
+	}{
+		{path: "bucket/object/xl.meta", volume: "bucket", file: "object/xl.meta", success: true},
+		{path: "bucket/*/xl.meta", volume: "bucket", file: "*/xl.meta", success: true},
+		{path: "bucket"},
+		{path: "bucket/"},
+		{path: "/object/xl.meta"},
+		{path: ""},
+	}
+	for i, tc := range testCases {
+		d, err := ParseInspectPath(tc.path)
+		if tc.success != (err == nil) {
+			t.Fatalf("case %d: unexpected error %v", i+1, err)
+		}
+		if d.Volume != tc.volume || d.File != tc.file {
+			t.Fatalf("case %d: expected %s/%s, got %s/%s", i+1, tc.volume, tc.file, d.Volume, d.File)
+		}
+	}
+}
+
+func TestInspectMaxBytes(t *testing.T) {
+	payload := bytes.Repeat([]byte("x"), 1000)
+	for _, chunked := range []bool{false, true} {
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if !chunked {
+				w.Header().Set("Content-Length", strconv.Itoa(1+32+len(payload)))
+			}
+			w.Write([]byte{1})
+			w.Write(bytes.Repeat([]byte{'k'}, 32))
+			if chunked {
+				w.(http.Flusher).Flush()
+			}
+			w.Write(payload)
+		})
+
+		for _, tc := range []struct {
+			max     int64
+			success bool
+		}{
+			{max: 0, success: true},
+			{max: 1 + 32 + int64(len(payload)), success: true},
+			{max: 500},
+		} {
+			_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", MaxBytes: tc.max})
+			if err == nil {
+				_, err = io.ReadAll(rc)
+				rc.Close()
+			}
+			if tc.success {
+				if err != nil {
+					t.Fatalf("chunked=%v max=%d: unexpected error %v", chunked, tc.max, err)
+				}
+				continue
+			}
+			if !errors.Is(err, ErrInspectTooLarge) {
+				t.Fatalf("chunked=%v max=%d: expected %v, got %v", chunked, tc.max, ErrInspectTooLarge, err)
+			}
+		}
+	}
+}
+
+func TestInspectMaxBytesErrorResponse(t *testing.T) {
+	message := strings.Repeat("access denied ", 100)
+	for _, status := range []int{http.StatusForbidden, http.StatusNotFound, http.StatusInternalServerError} {
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"Code":"XMinioAdminError","Message":"` + message + `"}`))
+		})
+
+		_, _, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", MaxBytes: 100, MaxRetries: -1})
+		if errors.Is(err, ErrInspectTooLarge) || ToErrorResponse(err).StatusCode() != status {
+			t.Fatalf("expected server error with status %d, got %v", status, err)
+		}
+		if ToErrorResponse(err).Message != message {
+			t.Fatalf("expected server error message, got %q", ToErrorResponse(err).Message)
+		}
+	}
+}
+
+func TestInspectEndpointOverride(t *testing.T) {
+	var path string
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte{2})
+	})
+
+	for _, tc := range []struct {
+		override, path string
+	}{
+		{override: "", path: "/minio/admin/v4/inspect-data"},
+		{override: "/custom/inspect", path: "/minio/admin/custom/inspect"},
+	} {
+		_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", EndpointOverride: tc.override})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rc.Close()
+		if path != tc.path {
+			t.Fatalf("expected request to %s, got %s", tc.path, path)
+		}
+	}
+
+	if _, _, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", EndpointOverride: "custom"}); err == nil {
+		t.Fatal("expected relative override to be rejected")
+	}
+}
+
+func TestInspectRawStream(t *testing.T) {
+	key := bytes.Repeat([]byte{'k'}, 32)
+	framed := append(binary.BigEndian.AppendUint64(nil, 4), []byte("data\x00\x00\x00\x00")...)
+	testCases := []struct {
+		format byte
+		body   []byte
+		key    []byte
+	}{
+		{format: 1, body: append(append([]byte{1}, key...), "data"...), key: key},
+		{format: 2, body: append([]byte{2}, "estream"...)},
+		{format: 4, body: append(append([]byte{4}, key...), framed...), key: key},
+		{format: 9, body: append([]byte{9}, "future"...)},
+	}
+	for _, tc := range testCases {
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write(tc.body)
+		})
+		res, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", RawStream: true})
+		if err != nil {
+			t.Fatalf("format %d: %v", tc.format, err)
+		}
+		data, err := io.ReadAll(res.Reader)
+		res.Reader.Close()
+		if err != nil {
+			t.Fatalf("format %d: %v", tc.format, err)
+		}
+		if res.Format != tc.format {
+			t.Fatalf("expected format %d, got %d", tc.format, res.Format)
+		}
+		if !bytes.Equal(res.Key, tc.key) {
+			t.Fatalf("format %d: unexpected key %q", tc.format, res.Key)
+		}
+		if want := tc.body[1+len(tc.key):]; !bytes.Equal(data, want) {
+			t.Fatalf("format %d: expected %q, got %q", tc.format, want, data)
+		}
+	}
+}
+
+func TestInspectHashInto(t *testing.T) {
+	key := bytes.Repeat([]byte{'k'}, 32)
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(append(append([]byte{1}, key...), "inspect data"...))
+	})
+	h := sha256.New()
+	res, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", HashInto: h})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(res.Reader)
+	res.Reader.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sha256.Sum256([]byte("inspect data"))
+	if string(data) != "inspect data" {
+		t.Fatalf("unexpected data %q", data)
+	}
+	if got := h.Sum(nil); !bytes.Equal(got, want[:]) {
+		t.Fatalf("expected hash %x, got %x", want, got)
+	}
+}
+
+func TestInspectExpectVersion(t *testing.T) {
+	key := bytes.Repeat([]byte{'k'}, 32)
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(append(append([]byte{1}, key...), "data"...))
+	})
+	testCases := []struct {
+		expect  byte
+		wantErr bool
+	}{
+		{expect: 0},
+		{expect: 1},
+		{expect: 2, wantErr: true},
+	}
+	for _, tc := range testCases {
+		res, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", ExpectVersion: tc.expect})
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("expect version %d: unexpected error %v", tc.expect, err)
+		}
+		if err == nil {
+			res.Reader.Close()
+		}
+	}
+}
+
+func TestInspectKeyEncoding(t *testing.T) {
+	key := bytes.Repeat([]byte{0xfe}, 32)
+	s, err := EncodeInspectKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeInspectKey(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, key) {
+		t.Fatalf("expected %x, got %x", key, got)
+	}
+	for _, k := range [][]byte{nil, key[:16], append(key, 0)} {
+		if _, err := EncodeInspectKey(k); err == nil {
+			t.Fatalf("expected error encoding a %d byte key", len(k))
+		}
+	}
+	for _, s := range []string{"", "not base64!", base64.StdEncoding.EncodeToString(key[:16])} {
+		if _, err := DecodeInspectKey(s); err == nil {
+			t.Fatalf("expected error decoding %q", s)
+		}
+	}
+}
+
+func TestInspectExtraHeaders(t *testing.T) {
+	key := bytes.Repeat([]byte{'k'}, 32)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)
+	for _, publicKey := range [][]byte{nil, pub} {
+		var got http.Header
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			got = r.Header.Clone()
+			if publicKey != nil {
+				w.Write(append([]byte{2}, "estream"...))
+				return
+			}
+			w.Write(append(append([]byte{1}, key...), "data"...))
+		})
+		extra := http.Header{}
+		extra.Set("X-Request-Id", "abc")
+		extra.Add("X-Forwarded-For", "10.0.0.1")
+		extra.Add("X-Forwarded-For", "10.0.0.2")
+		extra.Set("Content-Type", "text/plain")
+		extra["range"] = []string{"bytes=10-"}
+		res, err := adm.InspectWithResult(context.Background(), InspectOptions{
+			Volume: "bucket", File: "object/xl.meta", PublicKey: publicKey, ExtraHeaders: extra,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Reader.Close()
+		if v := got.Get("X-Request-Id"); v != "abc" {
+			t.Fatalf("expected request id header, got %q", v)
+		}
+		if v := got.Values("X-Forwarded-For"); !reflect.DeepEqual(v, []string{"10.0.0.1", "10.0.0.2"}) {
+			t.Fatalf("expected all forwarded for values, got %q", v)
+		}
+		if v := got.Get("Range"); v != "" {
+			t.Fatalf("expected no range header, got %q", v)
+		}
+		want := ""
+		if publicKey != nil {
+			want = "application/x-www-form-urlencoded"
+		}
+		if v := got.Get("Content-Type"); v != want {
+			t.Fatalf("expected content type %q, got %q", want, v)
+		}
+		if len(extra) != 4 {
+			t.Fatalf("extra headers modified: %v", extra)
+		}
+	}
+}
+
+func TestInspectUnknownVersion(t *testing.T) {
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(append([]byte{9}, "future"...))
+	})
+	_, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+	if !errors.Is(err, ErrUnknownInspectVersion) {
+		t.Fatalf("expected %v, got %v", ErrUnknownInspectVersion, err)
+	}
+	if want := "unknown data version"; err.Error() != want {
+		t.Fatalf("expected %q, got %q", want, err)
+	}
+	var verr *UnknownInspectVersionError
+	if !errors.As(err, &verr) || verr.Format != 9 {
+		t.Fatalf("expected format 9, got %v", err)
+	}
+}
+
+func TestInspectTar(t *testing.T) {
+	files := []string{"node1/drive1/bucket/object/xl.meta", "node2/drive1/bucket/object/xl.meta"}
+	adm := newTestAdminClient(t, inspectZipHandler(t, files...))
+
+	var buf bytes.Buffer
+	if err := adm.InspectTar(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(&buf)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "content of " + hdr.Name; string(data) != want {
+			t.Fatalf("expected %q, got %q", want, data)
+		}
+		names = append(names, hdr.Name)
+	}
+	if !reflect.DeepEqual(names, files) {
+		t.Fatalf("expected entries %v, got %v", files, names)
+	}
+
+	adm = newTestAdminClient(t, inspectZipHandler(t, "../escaped"))
+	if err := adm.InspectTar(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"}, io.Discard); err == nil {
+		t.Fatal("expected invalid path to be rejected")
+	}
+}
+
+func TestWriteInspectTar(t *testing.T) {
+	modified := time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC)
+	large := bytes.Repeat([]byte("large content"), inspectEntryMemLimit/10)
+	var zbuf bytes.Buffer
+	zw := zip.NewWriter(&zbuf)
+	for _, f := range []struct {
+		name string
+		data []byte
+	}{{"node1/large", large}, {"node1/dir/", nil}, {"node1/small", []byte("small")}} {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write(f.data)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeInspectTar(&zbuf, &buf); err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(&buf)
+	for _, want := range []struct {
+		name string
+		typ  byte
+		data []byte
+	}{{"node1/large", tar.TypeReg, large}, {"node1/dir/", tar.TypeDir, nil}, {"node1/small", tar.TypeReg, []byte("small")}} {
+		hdr, err := tr.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Name != want.name || hdr.Typeflag != want.typ || !bytes.Equal(data, want.data) {
+			t.Fatalf("expected %s with %d bytes, got %s with %d bytes", want.name, len(want.data), hdr.Name, len(data))
+		}
+		if !hdr.ModTime.Equal(modified) {
+			t.Fatalf("expected modification time %v of %s, got %v", modified, hdr.Name, hdr.ModTime)
+		}
+	}
+	if _, err := tr.Next(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestInspectPathEscaping(t *testing.T) {
+	names := []string{"my bucket/object with spaces", "bucket/a+b%20c", "bucket/объект/データ.bin", "bucket/x&y=z?#"}
+	key := bytes.Repeat([]byte{'k'}, 32)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)
+	for _, name := range names {
+		for _, publicKey := range [][]byte{nil, pub} {
+			var volume, file string
+			adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Error(err)
+				}
+				volume, file = r.Form.Get("volume"), r.Form.Get("file")
+				w.Write(append(append([]byte{1}, key...), "data"...))
+			})
+			opts, err := ParseInspectPath(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			opts.PublicKey = publicKey
+			res, err := adm.InspectWithResult(context.Background(), opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Reader.Close()
+			if volume != opts.Volume || file != opts.File {
+				t.Fatalf("expected %q/%q, server got %q/%q", opts.Volume, opts.File, volume, file)
+			}
+		}
+	}
+}
+
+func TestInspectOptionsValidate(t *testing.T) {
+	testCases := []struct {
+		opts    InspectOptions
+		success bool
+	}{
+		{opts: InspectOptions{Volume: "bucket", File: "object/xl.meta"}, success: true},
+		{opts: InspectOptions{File: "object/xl.meta"}},
+		{opts: InspectOptions{Volume: "bucket", Offset: -1}},
+		{opts: InspectOptions{Volume: "bucket", Offset: 10, PublicKey: []byte("key")}},
+		{opts: InspectOptions{Volume: "bucket", EndpointOverride: "inspect"}},
+		{opts: InspectOptions{Volume: "bucket", PublicKey: []byte("not-a-key")}},
+	}
+	for i, tc := range testCases {
+		if err := tc.opts.Validate(); (err == nil) != tc.success {
+			t.Fatalf("case %d: unexpected result %v", i+1, err)
+		}
+	}
+}
+
+func TestInspectReadTimeout(t *testing.T) {
+	key := bytes.Repeat([]byte{'k'}, 32)
+	opts := InspectOptions{Volume: "bucket", File: "object/xl.meta", ReadTimeout: 50 * time.Millisecond}
+
+	// Server not responding at all.
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	if _, _, err := adm.Inspect(context.Background(), opts); !errors.Is(err, ErrInspectReadTimeout) {
+		t.Fatalf("expected %v, got %v", ErrInspectReadTimeout, err)
+	}
+
+	// Server stalling after sending part of the data.
+	adm = newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(append(append([]byte{1}, key...), "partial"...))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+	_, rc, err := adm.Inspect(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(rc)
+	rc.Close()
+	if !errors.Is(err, ErrInspectReadTimeout) {
+		t.Fatalf("expected %v, got %v", ErrInspectReadTimeout, err)
+	}
+	if string(data) != "partial" {
+		t.Fatalf("expected data read before the stall, got %q", data)
+	}
+
+	// A slow reader doesn't trigger the timeout.
+	adm = newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(append(append([]byte{1}, key...), "complete"...))
+	})
+	_, rc, err = adm.Inspect(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * opts.ReadTimeout)
+	data, err = io.ReadAll(rc)
+	rc.Close()
+	if err != nil || string(data) != "complete" {
+		t.Fatalf("expected complete data, got %q, %v", data, err)
+	}
+}
+
+func TestInspectDriveUUID(t *testing.T) {
+	var got url.Values
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		w.Write([]byte{2})
+	})
+	for _, uuid := range []string{"1c9a6ee7-9f25-4a9c-8b5e-3f3b0d6e2a10", ""} {
+		_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", DriveUUID: uuid})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rc.Close()
+		if got.Get("drive-uuid") != uuid || got.Has("drive-uuid") != (uuid != "") {
+			t.Fatalf("expected drive-uuid %q, got %v", uuid, got)
+		}
+	}
+}
+
+func TestInspectCancelRead(t *testing.T) {
+	key := bytes.Repeat([]byte{'k'}, 32)
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(append(append([]byte{1}, key...), "partial"...))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, rc, err := adm.Inspect(ctx, InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := io.ReadAll(rc)
+		errCh <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected %v, got %v", context.Canceled, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read not interrupted by canceling the context")
+	}
+	if _, err := rc.Read(make([]byte, 1)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v after cancel, got %v", context.Canceled, err)
+	}
+}
+
+func TestInspectCloseCancelsRead(t *testing.T) {
+	key := bytes.Repeat([]byte{'k'}, 32)
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(append([]byte{1}, key...))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+	_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", Deadline: time.Now().Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := io.ReadAll(rc)
+		errCh <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	rc.Close()
+	select {
+	case err := <-errCh:
+		if err == nil {
+			t.Fatal("expected read to fail after close")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read not interrupted by closing the reader")
+	}
+}
+
+func TestInspectDeadlineKeepsServerError(t *testing.T) {
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	_, _, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", Deadline: time.Now().Add(time.Minute)})
+	if err == nil || errors.Is(err, ErrInspectDeadline) {
+		t.Fatalf("expected the server error, got %v", err)
+	}
+}
+
+func TestInspectMetaOnly(t *testing.T) {
+	var got url.Values
+	adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		w.Write([]byte{2})
+	})
+	for _, metaOnly := range []bool{true, false} {
+		_, rc, err := adm.Inspect(context.Background(), InspectOptions{Volume: "bucket", File: "object", MetaOnly: metaOnly})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rc.Close()
+		if got.Has("meta-only") != metaOnly || (metaOnly && got.Get("meta-only") != "true") {
+			t.Fatalf("meta only %v: unexpected query %v", metaOnly, got)
+		}
+	}
+}
+
+func TestInspectSize(t *testing.T) {
+	testCases := []struct {
+		status  int
+		length  string
+		want    int64
+		wantErr bool
+	}{
+		{status: http.StatusOK, length: "5368709120", want: 5 << 30},
+		{status: http.StatusMethodNotAllowed, want: -1},
+		{status: http.StatusForbidden, wantErr: true},
+	}
+	for _, tc := range testCases {
+		adm := newTestAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodHead {
+				t.Errorf("expected HEAD request, got %s", r.Method)
+			}
+			if r.URL.Query().Get("volume") != "bucket" {
+				t.Errorf("unexpected query %v", r.URL.Query())
+			}
+			if tc.length != "" {
+				w.Header().Set("Content-Length", tc.length)
+			}
+			w.WriteHeader(tc.status)
+		})
+		size, err := adm.InspectSize(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta"})
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("status %d: unexpected error %v", tc.status, err)
+		}
+		if err == nil && size != tc.want {
+			t.Fatalf("status %d: expected size %d, got %d", tc.status, tc.want, size)
+		}
+	}
+}
+
+// inspectReplayTransport replies to every request with a recorded body.
+type inspectReplayTransport struct {
+	body     []byte
+	requests []*http.Request
+}
+
+func (rt *inspectReplayTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, r)
+	return &http.Response{
+		StatusCode:    http.StatusOK,
+		Header:        make(http.Header),
+		Body:          io.NopCloser(bytes.NewReader(rt.body)),
+		ContentLength: int64(len(rt.body)),
+		Request:       r,
+	}, nil
+}
+
+func TestInspectCustomTransport(t *testing.T) {
+	payload := bytes.Repeat([]byte("replayed-inspect-data"), 1000)
+	body := append([]byte{4}, bytes.Repeat([]byte{'k'}, 32)...)
+	body = binary.BigEndian.AppendUint64(body, uint64(len(payload)))
+	body = append(body, payload...)
+	meta := []byte(`{"node":"node1"}`)
+	body = binary.BigEndian.AppendUint32(body, uint32(len(meta)))
+	body = append(body, meta...)
+
+	rt := &inspectReplayTransport{body: body}
+	adm, err := NewWithOptions("localhost:9000", &Options{
+		Creds:     credentials.NewStaticV4("minioadmin", "minioadmin", ""),
+		Transport: rt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := adm.InspectWithResult(context.Background(), InspectOptions{Volume: "bucket", File: "object/xl.meta", BufferSize: 64 << 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(res.Reader)
+	res.Reader.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, payload) {
+		t.Fatalf("expected %d replayed bytes, got %d", len(payload), len(data))
+	}
+	if res.Metadata["node"] != "node1" {
+		t.Fatalf("unexpected metadata %v", res.Metadata)
+	}
+	if len(rt.requests) != 1 || rt.requests[0].URL.Path != "/minio/admin/v4/inspect-data" {
+		t.Fatalf("unexpected requests %v", rt.requests)
+	}
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+// Package madmintest provides helpers for testing code using madmin types.
+package madmintest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+// AssertHealthInfoRoundTrip marshals info to JSON and unmarshals it,
+// failing t if the decoded health info differs from info, e.g. because a
+// field doesn't survive decoding. info should hold values as decoded from
+// JSON: times without a monotonic clock reading, nil rather than empty
+// omitempty slices and maps, and interface fields holding plain JSON values.
+func AssertHealthInfoRoundTrip(t testing.TB, info madmin.HealthInfoV2) {
+	t.Helper()
+	data, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("marshal health info: %v", err)
+	}
+	var decoded madmin.HealthInfoV2
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal health info: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, info) {
+		t.Fatalf("health info changed in JSON round trip: %s", valueDiff("info", reflect.ValueOf(info), reflect.ValueOf(decoded)))
+	}
+}
+
+// valueDiff returns a description of the first difference between the
+// values a and b, or an empty string if they are deeply equal.
+func valueDiff(path string, a, b reflect.Value) string {
+	if !a.IsValid() && !b.IsValid() {
+		return ""
+	}
+	if !a.IsValid() || !b.IsValid() {
+		return fmt.Sprintf("%s: %s != %s", path, describe(a), describe(b))
+	}
+	if a.Type() != b.Type() {
+		return fmt.Sprintf("%s: %s != %s", path, a.Type(), b.Type())
+	}
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			break
+		}
+		return valueDiff(path, a.Elem(), b.Elem())
+	case reflect.Struct:
+		if a.Type() == reflect.TypeOf(time.Time{}) {
+			break
+		}
+		for i := 0; i < a.NumField(); i++ {
+			if !a.Type().Field(i).IsExported() {
+				continue
+			}
+			if diff := valueDiff(path+"."+a.Type().Field(i).Name, a.Field(i), b.Field(i)); diff != "" {
+				return diff
+			}
+		}
+		// Fall back to comparing the unexported fields as a whole.
+	case reflect.Slice, reflect.Array:
+		if a.Kind() == reflect.Slice && a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			break
+		}
+		for i := 0; i < a.Len(); i++ {
+			if diff := valueDiff(fmt.Sprintf("%s[%d]", path, i), a.Index(i), b.Index(i)); diff != "" {
+				return diff
+			}
+		}
+		return ""
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() {
+			break
+		}
+		keys := make([]string, 0, a.Len()+b.Len())
+		values := map[string]reflect.Value{}
+		for _, m := range []reflect.Value{a, b} {
+			iter := m.MapRange()
+			for iter.Next() {
+				k := fmt.Sprint(iter.Key().Interface())
+				if _, ok := values[k]; !ok {
+					keys = append(keys, k)
+					values[k] = iter.Key()
+				}
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			key := values[k]
+			if diff := valueDiff(fmt.Sprintf("%s[%s]", path, k), a.MapIndex(key), b.MapIndex(key)); diff != "" {
+				return diff
+			}
+		}
+		return ""
+	}
+	if reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s != %s", path, describe(a), describe(b))
+}
+
+func describe(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<missing>"
+	}
+	if v.Kind() == reflect.Slice && v.IsNil() || v.Kind() == reflect.Map && v.IsNil() {
+		return fmt.Sprintf("%s(nil)", v.Type())
+	}
+	return fmt.Sprintf("%#v", v.Interface())
+}
@@ -0,0 +1,100 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmintest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
+)
+
+func TestAssertHealthInfoRoundTrip(t *testing.T) {
+	AssertHealthInfoRoundTrip(t, madmin.HealthInfoV2{
+		Version:   madmin.HealthInfoVersion2,
+		TimeStamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Sys: madmin.SysInfo{
+			CPUInfo: []madmin.CPUs{{NodeCommon: madmin.NodeCommon{Addr: "node1:9000"}}},
+			MemInfo: []madmin.MemInfo{{NodeCommon: madmin.NodeCommon{Addr: "node1:9000"}, Total: 100, Used: 40}},
+		},
+		Perf: madmin.PerfInfo{
+			Drives: []madmin.DrivePerfInfos{{
+				NodeCommon: madmin.NodeCommon{Addr: "node1:9000"},
+				SerialPerf: []madmin.DrivePerfInfo{{Path: "/mnt/drive1", Latency: madmin.Latency{Percentile99: 0.01}}},
+			}},
+		},
+	})
+}
+
+func TestValueDiff(t *testing.T) {
+	type inner struct {
+		B int
+	}
+	type value struct {
+		A int
+		S []int
+		M map[string]interface{}
+		P *inner
+	}
+	testCases := []struct {
+		a, b value
+		want string
+	}{
+		{a: value{A: 1, S: []int{1, 2}}, b: value{A: 1, S: []int{1, 2}}, want: ""},
+		{a: value{P: &inner{B: 1}}, b: value{P: &inner{B: 2}}, want: "v.P.B: 1 != 2"},
+		{a: value{S: []int{1, 2}}, b: value{S: []int{1, 3}}, want: "v.S[1]: 2 != 3"},
+		{a: value{S: []int{}}, b: value{}, want: "v.S: []int{} != []int(nil)"},
+		{a: value{M: map[string]interface{}{"a": 1}}, b: value{M: map[string]interface{}{}}, want: "v.M[a]: 1 != <missing>"},
+		{a: value{M: map[string]interface{}{"a": 1}}, b: value{M: map[string]interface{}{"a": 1.0}}, want: "v.M[a]: int != float64"},
+	}
+	for i, tc := range testCases {
+		if got := valueDiff("v", reflect.ValueOf(tc.a), reflect.ValueOf(tc.b)); got != tc.want {
+			t.Fatalf("case %d: expected %q, got %q", i+1, tc.want, got)
+		}
+	}
+}
+
+// fatalTB records the failure of an assertion.
+type fatalTB struct {
+	testing.TB
+	msg string
+}
+
+func (f *fatalTB) Helper() {}
+
+func (f *fatalTB) Fatalf(format string, args ...interface{}) {
+	f.msg = fmt.Sprintf(format, args...)
+}
+
+func TestAssertHealthInfoRoundTripFails(t *testing.T) {
+	tb := &fatalTB{TB: t}
+	AssertHealthInfoRoundTrip(tb, madmin.HealthInfoV2{
+		Sys: madmin.SysInfo{SysConfig: []madmin.SysConfig{{
+			Config: map[string]interface{}{"time-info": madmin.TimeInfo{TimeZone: "UTC"}},
+		}}},
+	})
+	want := "info.Sys.SysConfig[0].Config[time-info]: madmin.TimeInfo != map[string]interface {}"
+	if !strings.HasSuffix(tb.msg, want) {
+		t.Fatalf("expected failure %q, got %q", want, tb.msg)
+	}
+}